@@ -0,0 +1,80 @@
+package parsetime
+
+import (
+	"time"
+)
+
+// isLeap reports whether year is a leap year in the proleptic Gregorian calendar
+func isLeap(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// daysIn returns the number of days in the month of year
+func daysIn(month time.Month, year int) int {
+	switch month {
+	case time.February:
+		if isLeap(year) {
+			return 29
+		}
+
+		return 28
+	case time.April, time.June, time.September, time.November:
+		return 30
+	}
+
+	return 31
+}
+
+// calendarError returns the name of the component that makes the date/time
+// impossible, or "" if it is valid.
+// Hour 24 is valid only as 24:00:00, the end of the day.
+func calendarError(year, month, day, hour, min, sec, nsec int) string {
+	if month < 1 || month > 12 {
+		return "month"
+	}
+
+	if day < 1 || day > daysIn(time.Month(month), year) {
+		return "day"
+	}
+
+	if hour == 24 && (min != 0 || sec != 0 || nsec != 0) {
+		return "hour"
+	}
+
+	return ""
+}
+
+// isoWeekStart returns the Monday of ISO week 1 of year, the week with
+// January 4 in it
+func isoWeekStart(year int) time.Time {
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+	return jan4.AddDate(0, 0, -((int(jan4.Weekday()) + 6) % 7))
+}
+
+// weeksIn returns the number of ISO weeks in year, 52 or 53
+func weeksIn(year int) int {
+	_, week := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+	return week
+}
+
+// daysInYear returns the number of days in year
+func daysInYear(year int) int {
+	if isLeap(year) {
+		return 366
+	}
+
+	return 365
+}
+
+// weekDate converts an ISO week date to a calendar date.
+// The week-year can differ from the calendar year: 2009-W01-1 is 2008-12-29.
+// Weeks and weekdays past the end roll over.
+func weekDate(year, week, weekday int) time.Time {
+	return isoWeekStart(year).AddDate(0, 0, (week-1)*7+weekday-1)
+}
+
+// ordinalDate converts a year and day of the year to a calendar date.
+// Days past the end roll over.
+func ordinalDate(year, day int) time.Time {
+	return time.Date(year, time.January, day, 0, 0, 0, 0, time.UTC)
+}
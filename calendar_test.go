@@ -0,0 +1,101 @@
+package parsetime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDaysIn(test *testing.T) {
+	assert := assert.New(test)
+
+	assert.Equal(31, daysIn(time.January, 2006))
+	assert.Equal(28, daysIn(time.February, 2006))
+	assert.Equal(29, daysIn(time.February, 2004))
+	assert.Equal(28, daysIn(time.February, 1900))
+	assert.Equal(29, daysIn(time.February, 2000))
+	assert.Equal(30, daysIn(time.April, 2006))
+}
+
+func TestCalendarStrict(test *testing.T) {
+	assert := assert.New(test)
+	p, _ := New(WithStrict(), WithLocation(time.UTC))
+
+	errs := []struct {
+		value  string
+		field  string
+		offset int
+	}{
+		{"2006-02-29", "day", 8},
+		{"2006-02-30", "day", 8},
+		{"2006-04-31", "day", 8},
+		{"2006-01-02T24:30:00Z", "hour", 11},
+	}
+
+	for _, e := range errs {
+		_, err := p.ISO8601(e.value)
+
+		var perr *ParseError
+		assert.True(errors.As(err, &perr), e.value)
+		assert.True(errors.Is(err, ErrOutOfRange), e.value)
+		assert.Equal(e.field, perr.Field, e.value)
+		assert.Equal(e.offset, perr.Offset, e.value)
+	}
+
+	t, err := p.ISO8601("2004-02-29")
+	assert.Equal(nil, err, "Leap day must be valid")
+	assert.Equal(29, t.Day(), "Parse error")
+
+	t, err = p.ISO8601("2006-01-02T24:00:00Z")
+	assert.Equal(nil, err, "24:00:00 must be valid")
+	assert.Equal(time.Date(2006, time.January, 3, 0, 0, 0, 0, time.UTC), t, "24:00:00 is the end of the day")
+}
+
+func TestCalendarRollover(test *testing.T) {
+	assert := assert.New(test)
+	p, _ := New(WithLocation(time.UTC))
+
+	r, err := p.ParseDetailed("2006-02-30")
+	assert.Equal(nil, err, "Lenient mode must roll over")
+	assert.True(r.Rollover, "Rollover must be reported")
+	assert.Equal(time.Date(2006, time.March, 2, 0, 0, 0, 0, time.UTC), r.Time, "Parse error")
+
+	r, err = p.ParseDetailed("2006-01-02")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.False(r.Rollover, "Valid date must not roll over")
+}
+
+func TestWeekDate(test *testing.T) {
+	assert := assert.New(test)
+
+	tests := []struct {
+		year, week, weekday int
+		expected            time.Time
+	}{
+		{2006, 1, 1, time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC)},
+		{2006, 52, 7, time.Date(2006, time.December, 31, 0, 0, 0, 0, time.UTC)},
+		// the week-year starts in the previous calendar year
+		{2009, 1, 1, time.Date(2008, time.December, 29, 0, 0, 0, 0, time.UTC)},
+		{2004, 53, 7, time.Date(2005, time.January, 2, 0, 0, 0, 0, time.UTC)},
+		{2010, 1, 1, time.Date(2010, time.January, 4, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, t := range tests {
+		assert.Equal(t.expected, weekDate(t.year, t.week, t.weekday), "%d-W%02d-%d", t.year, t.week, t.weekday)
+	}
+
+	assert.Equal(53, weeksIn(2004), "2004 has 53 weeks")
+	assert.Equal(53, weeksIn(2009), "2009 has 53 weeks")
+	assert.Equal(52, weeksIn(2006), "2006 has 52 weeks")
+}
+
+func TestOrdinalDate(test *testing.T) {
+	assert := assert.New(test)
+
+	assert.Equal(time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC), ordinalDate(2006, 2), "Parse error")
+	assert.Equal(time.Date(2004, time.December, 31, 0, 0, 0, 0, time.UTC), ordinalDate(2004, 366), "Parse error")
+	assert.Equal(365, daysInYear(2006), "Incorrect days")
+	assert.Equal(366, daysInYear(2004), "Incorrect days")
+}
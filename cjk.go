@@ -0,0 +1,214 @@
+package parsetime
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	reCJK       = regexp.MustCompile(CJK)
+	reStrictCJK = anchor(CJK)
+)
+
+// japaneseEra is an era of the Japanese imperial calendar
+type japaneseEra struct {
+	name, abbr string
+	// start and end are the first and last day of the era,
+	// end is zero for the current era
+	start, end time.Time
+}
+
+// Meiji through Reiwa; Meiji is counted from the start of 1868
+var japaneseEras = []japaneseEra{
+	{"明治", "M", eraDay(1868, time.January, 1), eraDay(1912, time.July, 29)},
+	{"大正", "T", eraDay(1912, time.July, 30), eraDay(1926, time.December, 24)},
+	{"昭和", "S", eraDay(1926, time.December, 25), eraDay(1989, time.January, 7)},
+	{"平成", "H", eraDay(1989, time.January, 8), eraDay(2019, time.April, 30)},
+	{"令和", "R", eraDay(2019, time.May, 1), time.Time{}},
+}
+
+func eraDay(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// lookupEra returns the era named 平成 or H
+func lookupEra(name string) (japaneseEra, bool) {
+	for _, e := range japaneseEras {
+		if e.name == name || e.abbr == name {
+			return e, true
+		}
+	}
+
+	return japaneseEra{}, false
+}
+
+// contains reports whether the date is within the era
+func (e japaneseEra) contains(year int, month time.Month, day int) bool {
+	d := eraDay(year, month, day)
+	return !d.Before(e.start) && (e.end.IsZero() || !d.After(e.end))
+}
+
+// eraToYear converts a Japanese era year to the Gregorian year,
+// e.g. 平成18 -> 2006, 令和元 -> 2019.
+// Years past the end of an era are ErrOutOfRange.
+func eraToYear(era, year string) (int, error) {
+	val := 1
+	if year != "元" {
+		var err error
+		val, err = strconv.Atoi(year)
+		if err != nil {
+			return 0, ErrOutOfRange
+		}
+	}
+
+	e, ok := lookupEra(era)
+	if !ok {
+		return 0, ErrInvalidDateTime
+	}
+
+	if val < 1 || (!e.end.IsZero() && val > e.end.Year()-e.start.Year()+1) {
+		return 0, ErrOutOfRange
+	}
+
+	return e.start.Year() + val - 1, nil
+}
+
+// cjkWeekdays maps Japanese, Korean and Chinese weekday names
+var cjkWeekdays = map[string]time.Weekday{
+	"日": time.Sunday,
+	"月": time.Monday,
+	"火": time.Tuesday,
+	"水": time.Wednesday,
+	"木": time.Thursday,
+	"金": time.Friday,
+	"土": time.Saturday,
+	"일": time.Sunday,
+	"월": time.Monday,
+	"화": time.Tuesday,
+	"수": time.Wednesday,
+	"목": time.Thursday,
+	"금": time.Friday,
+	"토": time.Saturday,
+	"天": time.Sunday,
+	"一": time.Monday,
+	"二": time.Tuesday,
+	"三": time.Wednesday,
+	"四": time.Thursday,
+	"五": time.Friday,
+	"六": time.Saturday,
+}
+
+// cjkWeekdayNumber looks up 月, 月曜日, 월요일, 星期一 or 周日
+func cjkWeekdayNumber(name string) (time.Weekday, bool) {
+	for _, affix := range []string{"星期", "周", "週"} {
+		if strings.HasPrefix(name, affix) {
+			name = strings.TrimPrefix(name, affix)
+			if name == "日" {
+				return time.Sunday, true
+			}
+		}
+	}
+
+	for _, affix := range []string{"曜日", "曜", "요일"} {
+		name = strings.TrimSuffix(name, affix)
+	}
+
+	d, ok := cjkWeekdays[name]
+	return d, ok
+}
+
+// asciiDigits converts full-width digits (２００６) to ASCII
+func asciiDigits(value string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '０' && r <= '９' {
+			return r - '０' + '0'
+		}
+
+		return r
+	}, value)
+}
+
+func parseCJK(value string, ctx parseContext) (ParseResult, error) {
+	index := dateMatch(reCJK, value, ctx.match(reCJK, reStrictCJK, value), 12, false)
+
+	if index == nil {
+		return ParseResult{}, noMatchError(value, FormatCJK)
+	}
+
+	r := newResult(FormatCJK, value, index)
+	group := submatches(value, index)
+
+	for i := range group {
+		group[i].value = asciiDigits(group[i].value)
+	}
+
+	f := dateFields{
+		year:    group[3],
+		month:   group[4],
+		day:     group[5],
+		weekday: group[6],
+		hour:    group[8],
+		min:     group[9],
+		sec:     group[10],
+		nsec:    group[11],
+		offset:  group[12],
+	}
+
+	// 平成18 -> 2006
+	if group[1].value != "" {
+		year, err := eraToYear(group[1].value, group[2].value)
+		if err != nil {
+			return r, newParseError(value, FormatCJK, group[2], "year", err)
+		}
+
+		f.year = capture{value: strconv.Itoa(year), pos: group[1].pos}
+	}
+
+	// 午前0時 is midnight and 午後0時 is noon
+	if group[7].value != "" {
+		f.ampm = capture{value: "AM", pos: group[7].pos}
+		switch group[7].value {
+		case "午後", "下午", "오후":
+			f.ampm.value = "PM"
+		}
+
+		if n, err := strconv.Atoi(f.hour.value); err == nil && n == 0 {
+			f.hour.value = "12"
+		}
+	}
+
+	// 15時 -> 15:00
+	hourOnly := f.hour.value != "" && f.min.value == ""
+	if hourOnly {
+		f.min = capture{value: "0", pos: -1}
+	}
+
+	if err := f.resolve(&r, value, ctx); err != nil {
+		return r, err
+	}
+
+	// 平成31年5月1日 is 令和元年5月1日
+	if e, ok := lookupEra(group[1].value); ok && !e.contains(r.Time.Date()) {
+		err := newParseError(value, FormatCJK, group[1], "era", ErrOutOfRange)
+		if ctx.strict {
+			return r, err
+		}
+
+		r.Warnings = append(r.Warnings, err)
+	}
+
+	if hourOnly {
+		r.Precision = PrecisionHour
+	}
+
+	return r, nil
+}
+
+// CJK parses 2006年1月2日 15時04分05秒, 2006년 1월 2일, 2006/1/2(月) 15:04 and
+// Japanese era dates such as 平成18年1月2日 or H18.1.2
+func (pt *ParseTime) CJK(value string) (time.Time, error) {
+	r, err := pt.context().run(parseCJK, value)
+	return r.Time, err
+}
@@ -0,0 +1,173 @@
+package parsetime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCJK(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := New(WithFixedZone("JST", 9*3600))
+	loc := p.GetLocation()
+
+	date := time.Date(2006, time.January, 2, 0, 0, 0, 0, loc)
+
+	tests := map[string]time.Time{
+		"2006年1月2日":                   date,
+		"2006年01月02日":                 date,
+		"２００６年１月２日":                   date,
+		"2006年1月2日(月)":                date,
+		"2006年1月2日（月曜日）":              date,
+		"2006/1/2(月) 15:04":           time.Date(2006, time.January, 2, 15, 4, 0, 0, loc),
+		"2006年1月2日 15時04分05秒":         time.Date(2006, time.January, 2, 15, 4, 5, 0, loc),
+		"2006年1月2日 15時":               time.Date(2006, time.January, 2, 15, 0, 0, 0, loc),
+		"2006年1月2日 午後3時4分":            time.Date(2006, time.January, 2, 15, 4, 0, 0, loc),
+		"2006年1月2日 午前0時":              date,
+		"2006年1月2日 15时04分05秒":         time.Date(2006, time.January, 2, 15, 4, 5, 0, loc),
+		"2006년 1월 2일 (월) 15시 04분 05초": time.Date(2006, time.January, 2, 15, 4, 5, 0, loc),
+		"平成18年1月2日":                   date,
+		"H18.1.2":                     date,
+		"令和5年3月1日":                    time.Date(2023, time.March, 1, 0, 0, 0, 0, loc),
+		"令和元年5月1日":                    time.Date(2019, time.May, 1, 0, 0, 0, 0, loc),
+		"昭和64年1月7日":                   time.Date(1989, time.January, 7, 0, 0, 0, 0, loc),
+		"M45.7.30":                    time.Date(1912, time.July, 30, 0, 0, 0, 0, loc),
+	}
+
+	for in, expected := range tests {
+		t, err := p.CJK(in)
+		assert.Equal(nil, err, in)
+		assert.True(expected.Equal(t), "%s: %s", in, t)
+
+		r, err := p.ParseDetailed(in)
+		assert.Equal(nil, err, in)
+		assert.Equal(FormatCJK, r.Format, in)
+		assert.True(expected.Equal(r.Time), "%s: %s", in, r.Time)
+	}
+}
+
+func TestCJKPrecision(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := New(WithFixedZone("JST", 9*3600))
+
+	r, _ := p.ParseDetailed("2006年1月2日")
+	assert.Equal(PrecisionDay, r.Precision, "Incorrect precision")
+
+	r, _ = p.ParseDetailed("2006年1月2日 15時")
+	assert.Equal(PrecisionHour, r.Precision, "Incorrect precision")
+
+	r, _ = p.ParseDetailed("2006年1月2日 15時04分05秒")
+	assert.Equal(PrecisionSecond, r.Precision, "Incorrect precision")
+}
+
+func TestCJKTimeOfDay(test *testing.T) {
+	assert := assert.New(test)
+
+	jst := time.FixedZone("JST", 9*3600)
+	now := time.Date(2006, time.January, 2, 9, 0, 0, 0, jst)
+	p, _ := New(WithLocation(jst), WithClock(FixedClock(now)))
+
+	tests := map[string]time.Time{
+		"午後3時":     time.Date(2006, time.January, 2, 15, 0, 0, 0, jst),
+		"午前0時30分":  time.Date(2006, time.January, 2, 0, 30, 0, 0, jst),
+		"下午3点":     time.Date(2006, time.January, 2, 15, 0, 0, 0, jst),
+		"오후 3시 4분": time.Date(2006, time.January, 2, 15, 4, 0, 0, jst),
+	}
+
+	for in, expected := range tests {
+		r, err := p.ParseDetailed(in)
+		assert.Equal(nil, err, in)
+		assert.Equal(FormatCJK, r.Format, in)
+		assert.True(expected.Equal(r.Time), "%s: %s", in, r.Time)
+	}
+}
+
+func TestCJKWeekday(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := New(WithFixedZone("JST", 9*3600))
+
+	r, err := p.ParseDetailed("2006年1月2日(火)")
+	assert.Equal(nil, err, "mismatched weekday is a warning")
+	assert.Equal(1, len(r.Warnings), "Incorrect warnings")
+
+	p.SetWeekdayCheck(true)
+	_, err = p.Parse("2006年1月2日(火)")
+	assert.True(errors.Is(err, ErrWeekdayMismatch), "mismatched weekday")
+
+	for name, expected := range map[string]time.Weekday{
+		"月":   time.Monday,
+		"月曜日": time.Monday,
+		"월요일": time.Monday,
+		"星期一": time.Monday,
+		"周日":  time.Sunday,
+		"星期天": time.Sunday,
+	} {
+		weekday, ok := cjkWeekdayNumber(name)
+		assert.True(ok, name)
+		assert.Equal(expected, weekday, name)
+	}
+}
+
+func TestEraToYear(test *testing.T) {
+	assert := assert.New(test)
+
+	tests := []struct {
+		era, year string
+		expected  int
+	}{
+		{"明治", "1", 1868},
+		{"大正", "15", 1926},
+		{"S", "1", 1926},
+		{"平成", "元", 1989},
+		{"H", "31", 2019},
+		{"R", "5", 2023},
+	}
+
+	for _, t := range tests {
+		year, err := eraToYear(t.era, t.year)
+		assert.Equal(nil, err, t.era+t.year)
+		assert.Equal(t.expected, year, t.era+t.year)
+	}
+
+	_, err := eraToYear("平成", "32")
+	assert.True(errors.Is(err, ErrOutOfRange), "year past the end of the era")
+
+	_, err = eraToYear("H", "0")
+	assert.True(errors.Is(err, ErrOutOfRange), "year 0")
+
+	p, _ := New(WithFixedZone("JST", 9*3600))
+	_, err = p.CJK("平成32年1月2日")
+	assert.True(errors.Is(err, ErrOutOfRange), "year past the end of the era")
+}
+
+func TestEraDates(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := New(WithFixedZone("JST", 9*3600), WithStrict())
+	lenient, _ := New(WithFixedZone("JST", 9*3600))
+
+	for _, v := range []string{"平成31年4月30日", "令和元年5月1日", "S64.1.7", "H1.1.8", "大正元年7月30日"} {
+		_, err := p.CJK(v)
+		assert.Equal(nil, err, v)
+	}
+
+	// Heisei ended on 2019-04-30 and Shōwa on 1989-01-07
+	for _, v := range []string{"平成31年5月1日", "S64.1.8", "H1.1.7", "明治45年7月30日"} {
+		_, err := p.CJK(v)
+
+		var perr *ParseError
+		assert.True(errors.As(err, &perr), v)
+		assert.True(errors.Is(err, ErrOutOfRange), v)
+		assert.Equal("era", perr.Field, v)
+		assert.Equal(0, perr.Offset, v)
+
+		r, err := lenient.ParseDetailed(v)
+		assert.Equal(nil, err, v)
+		assert.Len(r.Warnings, 1, v)
+	}
+}
@@ -0,0 +1,43 @@
+package parsetime
+
+import (
+	"time"
+)
+
+// Clock provides the reference time used to fill in fields missing from
+// the input, e.g. the date of "15:04:05" or the year of "Jan 02 15:04:05".
+// It is read once per parse.
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc adapts a function to the Clock interface
+type ClockFunc func() time.Time
+
+// Now returns f()
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+type fixedClock struct {
+	t time.Time
+}
+
+func (c fixedClock) Now() time.Time {
+	return c.t
+}
+
+// FixedClock returns a Clock that always reports t,
+// e.g. a file modification time or a replay start time
+func FixedClock(t time.Time) Clock {
+	return fixedClock{t: t}
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// SystemClock is the Clock backed by time.Now
+var SystemClock Clock = systemClock{}
@@ -0,0 +1,40 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetClock(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime("UTC")
+	assert.Equal(SystemClock, p.GetClock(), "Default clock must be the system clock")
+
+	ref := time.Date(2016, time.May, 6, 23, 59, 59, 0, time.UTC)
+	p.SetClock(FixedClock(ref))
+
+	t, err := p.Parse("15:04:05")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2016, time.May, 6, 15, 4, 5, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+
+	t, err = p.Parse("Jan 02 15:04:05")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2016, time.January, 2, 15, 4, 5, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+}
+
+func TestClockInOffset(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime("UTC")
+	p.SetClock(ClockFunc(func() time.Time {
+		return time.Date(2016, time.May, 6, 23, 0, 0, 0, time.UTC)
+	}))
+
+	// the reference date is taken in the parsed offset
+	t, err := p.ISO8601("15:04:05+09:00")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(7, t.Day(), "Parse error")
+}
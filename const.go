@@ -2,64 +2,126 @@ package parsetime
 
 import (
 	"strings"
+	"time"
 )
 
 const (
-	year         = `(2[0-9]{3}|19[7-9][0-9])`
-	month        = `(1[012]|0?[1-9])`
-	day          = `([12][0-9]|3[01]|0?[1-9])`
-	hour         = `(2[0-3]|[01]?[0-9])`
-	min          = `([0-5]?[0-9])`
-	sec          = min
-	nsec         = `(?:[.])?([0-9]{1,9})?`
-	weekday      = `(?:Mon|Monday|Tue|Tuesday|Wed|Wednesday|Thu|Thursday|Fri|Friday|Sat|Saturday|Sun|Sunday)`
-	monthAbbr    = `(Jan|January|Feb|Februray|Mar|March|Apr|April|May|Jun|June|Jul|July|Aug|August|Sep|September|Oct|October|Nov|November|Dec|December|1[012]|0?[1-9])`
-	offset       = `(Z|[+-][01][1-9]:[0-9]{2})?`
-	zone         = `(?:[a-zA-Z0-9+-]{3,6})?`
-	ymdSep       = `[ /.-]?`
-	hmsSep       = `[ :.]?`
-	t            = `(?:t|T|\s*)?`
-	s            = `(?:\s*)?`
-	ampm         = `([aApP][mM])`
-	ampmHour     = `(1[01]|[0]?[0-9])`
-	shortYear    = `(2[0-9]{3}|19[7-9][0-9]|[0-9]{2})`
-	offsetZone   = `([+-][01][1-9]:[0-9]{2}|[a-zA-Z0-9+-]{3,6})?`
-	usOffsetZone = `(?:[(])?([+-][01][1-9]:[0-9]{2}|[a-zA-Z0-9+-]{3,6})?(?:[)])?`
+	year          = `([0-9]{4})`
+	month         = `(1[012]|0?[1-9])`
+	day           = `([12][0-9]|3[01]|0?[1-9])`
+	hour          = `(2[0-4]|[01]?[0-9])`
+	min           = `([0-5]?[0-9])`
+	sec           = min
+	nsec          = `(?:[.,]?([0-9]+))?`
+	weekdayNames  = `mon(?:day)?|tue(?:s(?:day)?)?|wed(?:nesday)?|thu(?:r(?:s(?:day)?)?)?|fri(?:day)?|sat(?:urday)?|sun(?:day)?`
+	monthNames    = `jan(?:uary)?|feb(?:ruary)?|mar(?:ch)?|apr(?:il)?|may|june?|july?|aug(?:ust)?|sep(?:t(?:ember)?)?|oct(?:ober)?|nov(?:ember)?|dec(?:ember)?`
+	weekday       = `((?i:` + weekdayNames + `)[.]?)`
+	monthAbbr     = `((?i:` + monthNames + `)[.]?|1[012]|0?[1-9])`
+	utcOffset     = `(?:(?:UTC|GMT)[+-][0-9]{1,2}|[+-][0-9]{2})(?::?[0-5][0-9]){0,2}`
+	offset        = `(Z|` + utcOffset + `)?`
+	zone          = `([a-zA-Z][a-zA-Z0-9+-]{2,5}\b)?`
+	ymdSep        = `[ /.-]?`
+	dateSep       = `[ /.-]`
+	month2        = `(1[012]|0[1-9])`
+	day2          = `(3[01]|[12][0-9]|0[1-9])`
+	durationValue = `([0-9]+(?:[.,][0-9]+)?)`
+	unitWords     = `years?|y|months?|mo|weeks?|w|days?|d|hours?|hrs?|h|minutes?|mins?|m|seconds?|secs?|s`
+	isoYear       = `([+-][0-9]{4,6}|[0-9]{4})`
+	isoBareYear   = isoYear + `\s*$`
+	isoWeek       = `([0-9]{2})`
+	isoWeekday    = `([1-7])`
+	ordinalDay    = `([0-9]{3})`
+	hmsSep        = `[ :.]?`
+	t             = `(?:t|T|\s*)?`
+	s             = `(?:\s*)?`
+	ampm          = `([aApP][.]?[mM][.]?)`
+	ampmHour      = `(2[0-4]|1[0-9]|0?[0-9])`
+	dayWord       = `((?i:noon|midnight))`
+	connector     = `(?:at)?`
+	wideDigit     = `[0-9０-９]`
+	era           = `(明治|大正|昭和|平成|令和|[MTSHR])`
+	cjkWeekday    = `([日月火水木金土](?:曜日?)?|[일월화수목금토](?:요일)?|(?:星期|周|週)[一二三四五六日天]|(?i:` + weekdayNames + `))`
+	cjkAMPM       = `(午前|午後|上午|下午|오전|오후)`
+	shortYear     = `([0-9]{4}|[0-9]{2})`
+	offsetZone    = `(` + utcOffset + `|[a-zA-Z][a-zA-Z0-9+-]{2,5}\b)?`
+	usOffsetZone  = `(?:[(])?(` + utcOffset + `|[a-zA-Z][a-zA-Z0-9+-]{2,5}\b)?(?:[)])?`
 )
 
 // Regular expressions
 var (
 	// ISO8601, RFC3339
+	// a date without separators needs two-digit month and day (20060102)
+	// so that hhmmss times are not read as years.
+	// Week dates 2006-W01-1, 2006W011, ordinal dates 2006-002, 2006002,
+	// reduced precision 2006-01, 2006, 2006-01-02T15 and expanded years
+	// +002006-01-02, -0044-03-15.
+	// A year alone must end the input, so 1504 -07:00 is 15:04 at -07:00
 	ISO8601 = strings.Join([]string{
-		`(?:`, year, ymdSep, month, ymdSep, day, `)?`, t,
-		`(?:`, hour, hmsSep, min, hmsSep, sec, `?`, nsec, `)?`,
+		`(?:`, isoYear, dateSep, month, dateSep, day, `|`, year, month2, day2,
+		`|`, year, `-?W`, isoWeek, `(?:-?`, isoWeekday, `)?`,
+		`|`, year, `-?`, ordinalDay,
+		`|`, isoYear, `-`, month2, `\b`,
+		`|`, isoBareYear, `)?`, t,
+		`(?:`, hour, `(?:`, hmsSep, min, `(?:`, hmsSep, sec, `)?)?`, nsec, `)?`,
 		s, offset, s, zone,
 	}, "")
 
 	// RFC822, RFC850, RFC1123
-	RFC8xx1123 = strings.Join([]string{
-		`(?:`, weekday, `,?`, s, `)?`, day, ymdSep, monthAbbr, ymdSep, shortYear,
-		hmsSep, `(?:`, hour, hmsSep, min, hmsSep, sec, `?`, nsec, `)?`,
+	RFC8xx1123 = englishWords.rfc8xx1123()
+
+	ANSIC = englishWords.ansic()
+
+	US = englishWords.us()
+
+	// DD/MM/YYYY
+	DMY = englishWords.dmy()
+
+	// YY/MM/DD
+	YMD = englishWords.ymd()
+
+	englishWords = words{
+		weekday:   weekday,
+		month:     monthAbbr,
+		ampm:      ampm,
+		dateSep:   ymdSep,
+		connector: connector,
+	}
+
+	// 2006年1月2日(月) 15時04分05秒, 2006년 1월 2일 15시 04분, 平成18年1月2日, H18.1.2
+	// and a time of day alone such as 午後3時
+	CJK = strings.Join([]string{
+		`(?:(?:`, era, s, `(元|`, wideDigit, `{1,2})|(`, wideDigit, `{4}))`, s, `[年년/.-]`, s,
+		`(`, wideDigit, `{1,2})`, s, `[月월/.-]`, s, `(`, wideDigit, `{1,2})`, s, `[日일]?`,
+		`(?:`, s, `[(（]`, s, cjkWeekday, s, `[)）]`, `)?)?`,
+		`(?:`, s, cjkAMPM, `?`, s, `(`, wideDigit, `{1,2})`, s, `[時时点시:]`,
+		`(?:`, s, `(`, wideDigit, `{1,2})`, s, `[分분:]?`,
+		`(?:`, s, `(`, wideDigit, `{1,2})`, `(?:[.．](`, wideDigit, `{1,9}))?`, s, `[秒초]?`, `)?)?)?`,
 		s, offsetZone,
 	}, "")
 
-	ANSIC = strings.Join([]string{
-		`(?:`, weekday, s, `)?`, monthAbbr, ymdSep, day, ymdSep,
-		`(?:`, hour, hmsSep, min, hmsSep, sec, `?`, nsec, `)?`,
-		s, `(?:`, offsetZone, s, year, `)?`,
+	// ISO 8601 duration P1Y2M10DT2H30M, PT0.5S, P2W, -P1D
+	ISO8601Duration = strings.Join([]string{
+		`^\s*([+-])?P`,
+		`(?:`, durationValue, `Y)?(?:`, durationValue, `M)?(?:`, durationValue, `W)?(?:`, durationValue, `D)?`,
+		`(?:(T)(?:`, durationValue, `H)?(?:`, durationValue, `M)?(?:`, durationValue, `S)?)?\s*$`,
 	}, "")
 
-	US = strings.Join([]string{
-		`(?:`, monthAbbr, ymdSep, day, `(?:,)?`, ymdSep, shortYear, `)?`, s, `(?:at)?`, s,
-		`(?:`, hour, hmsSep, min, hmsSep, sec, `?`, nsec, `)?`,
-		s, ampm, `?`, s, usOffsetZone,
+	// now, today, tomorrow 5pm, last monday, next month, 3 days ago, in 2h
+	Relative = strings.Join([]string{
+		`(?i:\b(?:(now)|(today|tomorrow|yesterday)`,
+		`|(last|next|this)\s+(`, weekdayNames, `|week|month|year)`,
+		`|([0-9]+|an?)\s*(`, unitWords, `)\s+ago`,
+		`|in\s+([0-9]+|an?)\s*(`, unitWords, `))\b)`,
 	}, "")
 
+	// Unix epoch seconds, milliseconds, microseconds or nanoseconds
+	Unix = `^\s*(-)?([0-9]{1,19})(?:[.]([0-9]{1,9}))?\s*$`
+
 	Months = map[string]int{
 		"Jan":       1,
 		"January":   1,
 		"Feb":       2,
-		"Februray":  2,
+		"February":  2,
 		"Mar":       3,
 		"March":     3,
 		"Apr":       4,
@@ -72,6 +134,7 @@ var (
 		"Aug":       8,
 		"August":    8,
 		"Sep":       9,
+		"Sept":      9,
 		"September": 9,
 		"Oct":       10,
 		"October":   10,
@@ -80,4 +143,74 @@ var (
 		"Dec":       12,
 		"December":  12,
 	}
+
+	Weekdays = map[string]time.Weekday{
+		"Sun":       time.Sunday,
+		"Sunday":    time.Sunday,
+		"Mon":       time.Monday,
+		"Monday":    time.Monday,
+		"Tue":       time.Tuesday,
+		"Tues":      time.Tuesday,
+		"Tuesday":   time.Tuesday,
+		"Wed":       time.Wednesday,
+		"Wednesday": time.Wednesday,
+		"Thu":       time.Thursday,
+		"Thur":      time.Thursday,
+		"Thurs":     time.Thursday,
+		"Thursday":  time.Thursday,
+		"Fri":       time.Friday,
+		"Friday":    time.Friday,
+		"Sat":       time.Saturday,
+		"Saturday":  time.Saturday,
+	}
 )
+
+// words holds the language-dependent fragments of the formats that contain
+// month, weekday or AM/PM names
+type words struct {
+	weekday, month, ampm, dateSep, connector string
+}
+
+func (w words) rfc8xx1123() string {
+	return strings.Join([]string{
+		`(?:`, w.weekday, `,?`, s, `)?`, day, w.dateSep, w.month, w.dateSep, shortYear,
+		hmsSep, `(?:`, hour, hmsSep, min, hmsSep, sec, `?`, nsec, `)?`,
+		s, offsetZone,
+	}, "")
+}
+
+func (w words) ansic() string {
+	return strings.Join([]string{
+		`(?:`, w.weekday, s, `)?`, w.month, w.dateSep, day, w.dateSep,
+		`(?:`, hour, hmsSep, min, hmsSep, sec, `?`, nsec, `)?`,
+		s, `(?:`, offsetZone, s, year, `)?`,
+	}, "")
+}
+
+// clock is the time of day on the 12-hour or 24-hour clock, shared by US, DMY and YMD
+func (w words) clock() string {
+	return strings.Join([]string{
+		s, w.connector, s,
+		`(?:`, ampmHour, s, w.ampm, `|`, dayWord, `|`,
+		`(?:`, hour, hmsSep, min, hmsSep, sec, `?`, nsec, `)?`,
+		s, w.ampm, `?)`, s, usOffsetZone,
+	}, "")
+}
+
+func (w words) us() string {
+	return strings.Join([]string{
+		`(?:`, w.weekday, `,?`, s, `)?`, `(?:`, w.month, w.dateSep, day, `(?:,)?`, w.dateSep, shortYear, `)?`, w.clock(),
+	}, "")
+}
+
+func (w words) dmy() string {
+	return strings.Join([]string{
+		`(?:`, w.weekday, `,?`, s, `)?`, `(?:`, day, w.dateSep, w.month, `(?:,)?`, w.dateSep, shortYear, `)?`, w.clock(),
+	}, "")
+}
+
+func (w words) ymd() string {
+	return strings.Join([]string{
+		`(?:`, w.weekday, `,?`, s, `)?`, `(?:`, shortYear, w.dateSep, w.month, w.dateSep, day, `)?`, w.clock(),
+	}, "")
+}
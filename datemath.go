@@ -0,0 +1,122 @@
+package parsetime
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FormatDateMath is the format name reported in date math errors
+const FormatDateMath = "DateMath"
+
+// +1d, -15m, /w
+var reDateMathOp = regexp.MustCompile(`^\s*(?:([+-])([0-9]*)|(/))([yMwdhHms])`)
+
+// dateMathUnits maps the date math units to relativeUnits keys;
+// M is months and m minutes
+var dateMathUnits = map[string]string{
+	"y": "year",
+	"M": "month",
+	"w": "week",
+	"d": "day",
+	"h": "hour",
+	"H": "hour",
+	"m": "minute",
+	"s": "second",
+}
+
+// DateMath evaluates a date math expression such as now-15m, now-1d/d,
+// now/w or 2006-01-02||+1M/d. The anchor is now or a date/time parsed with
+// Parse and followed by ||. It is followed by any number of additions and
+// subtractions of y, M, w, d, h, m or s and roundings down to the start of
+// a unit. The result is in the ParseTime location, and so are days and
+// roundings.
+func (pt *ParseTime) DateMath(value string) (time.Time, error) {
+	return pt.dateMath(value, false)
+}
+
+// DateMathRoundUp is DateMath rounding up to the last nanosecond of a unit,
+// e.g. now/d is 23:59:59.999999999 today, for the inclusive end of a range
+func (pt *ParseTime) DateMathRoundUp(value string) (time.Time, error) {
+	return pt.dateMath(value, true)
+}
+
+func (pt *ParseTime) dateMath(value string, roundUp bool) (time.Time, error) {
+	ctx := pt.context()
+	n := ctx.normalize(value)
+
+	t, err := pt.evalDateMath(n.value, ctx, roundUp)
+	return t, n.restoreError(err)
+}
+
+func (pt *ParseTime) evalDateMath(value string, ctx parseContext, roundUp bool) (time.Time, error) {
+	var t time.Time
+	pos := 0
+
+	if i := strings.Index(value, "||"); i >= 0 {
+		results, err := parseAll(value[:i], ctx)
+		if err != nil {
+			var perr *ParseError
+			if errors.As(err, &perr) {
+				e := *perr
+				e.Input = value
+				return time.Time{}, &e
+			}
+
+			return time.Time{}, err
+		}
+
+		t, pos = results[0].Time.In(ctx.loc), i+len("||")
+	} else {
+		trimmed := strings.TrimLeft(value, " \t")
+		if !strings.HasPrefix(trimmed, "now") {
+			return time.Time{}, noMatchError(value, FormatDateMath)
+		}
+
+		t, pos = ctx.now.In(ctx.loc), len(value)-len(trimmed)+len("now")
+	}
+
+	for strings.TrimSpace(value[pos:]) != "" {
+		rest := value[pos:]
+		index := reDateMathOp.FindStringSubmatchIndex(rest)
+		if index == nil {
+			c := unmatched(value, ParseResult{Start: 0, End: pos})
+			return time.Time{}, newParseError(value, FormatDateMath, c, "operation", ErrInvalidDateTime)
+		}
+
+		group := submatches(rest, index)
+		u := relativeUnits[dateMathUnits[group[4].value]]
+
+		if group[3].value != "" {
+			t = truncate(t, u.precision)
+			if roundUp {
+				t, _ = u.shift(t, 1)
+				t = t.Add(-time.Nanosecond)
+			}
+		} else {
+			count := capture{value: group[2].value, pos: pos + group[2].pos}
+			n := 1
+			if count.value != "" {
+				var err error
+				if n, err = strconv.Atoi(count.value); err != nil {
+					return time.Time{}, newParseError(value, FormatDateMath, count, "count", ErrOutOfRange)
+				}
+			}
+
+			if group[1].value == "-" {
+				n = -n
+			}
+
+			var err error
+			if t, err = u.shift(t, n); err != nil {
+				return time.Time{}, newParseError(value, FormatDateMath, count, "count", err)
+			}
+		}
+
+		pos += index[1]
+	}
+
+	return t, nil
+}
@@ -0,0 +1,139 @@
+package parsetime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateMath(test *testing.T) {
+	assert := assert.New(test)
+
+	// Wednesday
+	ref := time.Date(2006, time.January, 4, 15, 4, 5, 999, time.UTC)
+	p, _ := New(WithLocation(time.UTC), WithClock(FixedClock(ref)))
+
+	tests := []struct {
+		value    string
+		expected time.Time
+	}{
+		{"now", ref},
+		{"now-15m", ref.Add(-15 * time.Minute)},
+		{"now+1h", ref.Add(time.Hour)},
+		{"now-1d/d", time.Date(2006, time.January, 3, 0, 0, 0, 0, time.UTC)},
+		{"now/w", time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC)},
+		{"now/M", time.Date(2006, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{"now/y", time.Date(2006, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{"now/H", time.Date(2006, time.January, 4, 15, 0, 0, 0, time.UTC)},
+		{"now/m", time.Date(2006, time.January, 4, 15, 4, 0, 0, time.UTC)},
+		{"now/s", time.Date(2006, time.January, 4, 15, 4, 5, 0, time.UTC)},
+		{"now-1y+2M-1w+3d", ref.AddDate(-1, 2, -7+3)},
+		{"now-d", ref.AddDate(0, 0, -1)},
+		{" now-1d/d ", time.Date(2006, time.January, 3, 0, 0, 0, 0, time.UTC)},
+		{"2006-01-02||+1M/d", time.Date(2006, time.February, 2, 0, 0, 0, 0, time.UTC)},
+		{"2006-01-31||+1M", time.Date(2006, time.March, 3, 0, 0, 0, 0, time.UTC)},
+		{"2006-01-02T15:04:05Z||", time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)},
+		{"Jan 2, 2006 at 3:04pm||-1h", time.Date(2006, time.January, 2, 14, 4, 0, 0, time.UTC)},
+	}
+
+	for _, t := range tests {
+		tm, err := p.DateMath(t.value)
+		assert.Equal(nil, err, t.value)
+		assert.Equal(t.expected, tm, t.value)
+	}
+}
+
+func TestDateMathRoundUp(test *testing.T) {
+	assert := assert.New(test)
+
+	ref := time.Date(2006, time.January, 4, 15, 4, 5, 0, time.UTC)
+	p, _ := New(WithLocation(time.UTC), WithClock(FixedClock(ref)))
+
+	tests := []struct {
+		value    string
+		expected time.Time
+	}{
+		{"now/d", time.Date(2006, time.January, 4, 23, 59, 59, 999999999, time.UTC)},
+		{"now/w", time.Date(2006, time.January, 8, 23, 59, 59, 999999999, time.UTC)},
+		{"2006-02-10||/M", time.Date(2006, time.February, 28, 23, 59, 59, 999999999, time.UTC)},
+		{"now-1h", ref.Add(-time.Hour)},
+	}
+
+	for _, t := range tests {
+		tm, err := p.DateMathRoundUp(t.value)
+		assert.Equal(nil, err, t.value)
+		assert.Equal(t.expected, tm, t.value)
+	}
+}
+
+func TestDateMathReadsClockOnce(test *testing.T) {
+	assert := assert.New(test)
+
+	ref := time.Date(2006, time.January, 4, 15, 4, 5, 0, time.UTC)
+	reads := 0
+	p, _ := New(WithLocation(time.UTC), WithClock(ClockFunc(func() time.Time {
+		reads++
+		return ref.Add(time.Duration(reads-1) * time.Hour)
+	})))
+
+	tm, err := p.DateMath("now||-1d")
+	assert.Equal(nil, err)
+	assert.Equal(ref.AddDate(0, 0, -1), tm)
+	assert.Equal(1, reads, "The clock must be read once")
+}
+
+func TestDateMathLocation(test *testing.T) {
+	assert := assert.New(test)
+
+	loc, _ := time.LoadLocation("America/New_York")
+	// 2006-04-03 01:30 EDT, the day after the DST change
+	ref := time.Date(2006, time.April, 3, 5, 30, 0, 0, time.UTC)
+	p, _ := New(WithLocation(loc), WithClock(FixedClock(ref)))
+
+	tm, err := p.DateMath("now/d")
+	assert.Equal(nil, err)
+	assert.Equal(time.Date(2006, time.April, 3, 0, 0, 0, 0, loc), tm, "rounded in the ParseTime location")
+
+	tm, _ = p.DateMath("now-1d")
+	assert.Equal(time.Date(2006, time.April, 2, 1, 30, 0, 0, loc), tm, "a day keeps the wall clock")
+
+	tm, _ = p.DateMath("now-24h")
+	assert.Equal(time.Date(2006, time.April, 2, 0, 30, 0, 0, loc), tm, "24 hours is elapsed time")
+
+	tm, _ = p.DateMath("2006-04-02T12:00:00Z||/d")
+	assert.Equal(time.Date(2006, time.April, 2, 0, 0, 0, 0, loc), tm, "anchor offset")
+}
+
+func TestDateMathErrors(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := New(WithLocation(time.UTC), WithWeekdayCheck())
+
+	tests := []struct {
+		value  string
+		err    error
+		field  string
+		offset int
+	}{
+		{"2006-01-02", ErrInvalidDateTime, "", 0},
+		{"now-1x", ErrInvalidDateTime, "operation", 3},
+		{"now+1d junk", ErrInvalidDateTime, "operation", 7},
+		{"now-99999999999999999999d", ErrOutOfRange, "count", 4},
+		{"now-9999999999h", ErrOutOfRange, "count", 4},
+		{"Fri, 02 Jan 2006||+1d", ErrWeekdayMismatch, "weekday", 0},
+	}
+
+	for _, t := range tests {
+		_, err := p.DateMath(t.value)
+		assert.True(errors.Is(err, t.err), "%s: %v", t.value, err)
+
+		var perr *ParseError
+		if assert.True(errors.As(err, &perr), t.value) {
+			assert.Equal(t.value, perr.Input, t.value)
+			assert.Equal(t.field, perr.Field, t.value)
+			assert.Equal(t.offset, perr.Offset, t.value)
+		}
+	}
+}
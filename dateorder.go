@@ -0,0 +1,152 @@
+package parsetime
+
+import (
+	"regexp"
+	"strconv"
+)
+
+// DateOrder is the order of day, month and year in numeric dates such as 02/01/2006
+type DateOrder int
+
+// Date orders
+const (
+	// DateOrderAuto accepts both month-first and day-first numeric dates,
+	// prefers month-first when both are valid and flags the result with
+	// AmbiguousDateOrder
+	DateOrderAuto DateOrder = iota
+	// DateOrderMDY reads numeric dates month first (01/02/2006 is January 2)
+	DateOrderMDY
+	// DateOrderDMY reads numeric dates day first (02/01/2006 is January 2)
+	DateOrderDMY
+	// DateOrderYMD reads numeric dates year first (06/01/02 is January 2)
+	DateOrderYMD
+)
+
+var dateOrderNames = []string{"auto", "MDY", "DMY", "YMD"}
+
+func (o DateOrder) String() string {
+	if o < 0 || int(o) >= len(dateOrderNames) {
+		return "unknown"
+	}
+
+	return dateOrderNames[o]
+}
+
+// isNumericMonth reports whether month is present and not a month name
+func isNumericMonth(month string) bool {
+	if month == "" {
+		return false
+	}
+
+	for _, c := range month {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// numericDateOrder returns order for a numeric month and DateOrderAuto otherwise
+func numericDateOrder(order DateOrder, month string) DateOrder {
+	if isNumericMonth(month) {
+		return order
+	}
+
+	return DateOrderAuto
+}
+
+// acceptsDateOrder reports whether a date read in the given order may be
+// used. Dates with a month name are unambiguous and always accepted.
+func (ctx parseContext) acceptsDateOrder(order DateOrder, month string) bool {
+	if !isNumericMonth(month) {
+		return true
+	}
+
+	switch ctx.dateOrder {
+	case DateOrderAuto:
+		return order != DateOrderYMD
+	default:
+		return order == ctx.dateOrder
+	}
+}
+
+// preferredDateOrder returns the order that wins ties between readings
+func (ctx parseContext) preferredDateOrder() DateOrder {
+	if ctx.dateOrder == DateOrderAuto {
+		return DateOrderMDY
+	}
+
+	return ctx.dateOrder
+}
+
+// dateOrderFormats are the formats that read numeric dates in each order
+var dateOrderFormats = map[DateOrder]string{
+	DateOrderMDY: FormatUS,
+	DateOrderDMY: FormatDMY,
+	DateOrderYMD: FormatYMD,
+}
+
+var (
+	// 01/13/2006, 13.01.06
+	reNumericDate = regexp.MustCompile(`\b([0-9]{1,2})[/.-]([0-9]{1,2})[/.-](?:[0-9]{4}|[0-9]{2})\b`)
+	// 2006/13/01
+	reNumericDateYMD = regexp.MustCompile(`\b(?:[0-9]{4}|[0-9]{2})[/.-]([0-9]{1,2})[/.-]([0-9]{1,2})\b`)
+)
+
+// checkNumericDate reports the month or day out of range in a numeric date
+// read in the configured order, such as the month 13 of 01/13/2006 with
+// DateOrderDMY, which the date patterns do not match
+func (ctx parseContext) checkNumericDate(value, format string, order DateOrder) (ParseResult, error) {
+	re := reNumericDate
+	if order == DateOrderYMD {
+		re = reNumericDateYMD
+	}
+
+	index := re.FindStringSubmatchIndex(value)
+	if ctx.dateOrder != order || index == nil {
+		return ParseResult{}, nil
+	}
+
+	group := submatches(value, index)
+	month, day := group[1], group[2]
+	if order == DateOrderDMY {
+		month, day = day, month
+	}
+
+	r := newResult(format, value, index)
+	if n, _ := strconv.Atoi(month.value); n < 1 || n > 12 {
+		return r, newParseError(value, format, month, "month", ErrOutOfRange)
+	}
+
+	if n, _ := strconv.Atoi(day.value); n < 1 || n > 31 {
+		return r, newParseError(value, format, day, "day", ErrOutOfRange)
+	}
+
+	return r, nil
+}
+
+type parser struct {
+	format string
+	parse  func(string, parseContext) (ParseResult, error)
+}
+
+// parsers returns the date/time parsers in the order ties are broken.
+// YMD is only tried with DateOrderYMD.
+func (ctx parseContext) parsers() []parser {
+	parsers := []parser{
+		{FormatISO8601, parseISO8601},
+		{FormatRFC8xx1123, parseRFC8xx1123},
+		{FormatANSIC, parseANSIC},
+		{FormatUS, parseUS},
+		{FormatDMY, parseDMY},
+		{FormatCJK, parseCJK},
+		{FormatRelative, parseRelative},
+	}
+
+	if ctx.dateOrder == DateOrderYMD {
+		parsers = append(parsers, parser{FormatYMD, parseYMD})
+	}
+
+	return parsers
+}
@@ -0,0 +1,112 @@
+package parsetime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateOrder(test *testing.T) {
+	assert := assert.New(test)
+
+	dates := []struct {
+		order DateOrder
+		value string
+		want  time.Time
+	}{
+		{DateOrderAuto, "02/01/2006", time.Date(2006, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		{DateOrderAuto, "13/01/2006", time.Date(2006, time.January, 13, 0, 0, 0, 0, time.UTC)},
+		{DateOrderMDY, "02/01/2006", time.Date(2006, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		{DateOrderMDY, "02-01-06 3:04 PM", time.Date(2006, time.February, 1, 15, 4, 0, 0, time.UTC)},
+		{DateOrderDMY, "02/01/2006", time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC)},
+		{DateOrderDMY, "02/01/2006 15:04", time.Date(2006, time.January, 2, 15, 4, 0, 0, time.UTC)},
+		{DateOrderDMY, "02-01-06 3:04 PM", time.Date(2006, time.January, 2, 15, 4, 0, 0, time.UTC)},
+		{DateOrderYMD, "06/01/02", time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC)},
+		{DateOrderYMD, "2006-01-02", time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC)},
+		{DateOrderDMY, "2 Jan 2006", time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, d := range dates {
+		p, _ := New(WithLocation(time.UTC), WithDateOrder(d.order))
+		t, err := p.Parse(d.value)
+		assert.Equal(nil, err, d.order.String()+" "+d.value)
+		assert.Equal(d.want, t, d.order.String()+" "+d.value)
+	}
+}
+
+func TestDateOrderAmbiguity(test *testing.T) {
+	assert := assert.New(test)
+	p, _ := New(WithLocation(time.UTC))
+	assert.Equal(DateOrderAuto, p.GetDateOrder(), "Default date order must be auto")
+
+	results, err := p.ParseAll("02/01/2006")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(FormatUS, results[0].Format, "Month first must win in auto mode")
+	assert.True(results[0].Ambiguity.Has(AmbiguousDateOrder), "Date order must be ambiguous")
+
+	var dmy *ParseResult
+	for i := range results {
+		if results[i].Format == FormatDMY {
+			dmy = &results[i]
+		}
+	}
+	assert.NotNil(dmy, "Day first reading must be reported")
+	assert.Equal(time.January, dmy.Time.Month(), "Incorrect day first reading")
+
+	r, err := p.ParseDetailed("13/01/2006")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.False(r.Ambiguity.Has(AmbiguousDateOrder), "Only one reading is valid")
+
+	p.SetDateOrder(DateOrderDMY)
+	r, err = p.ParseDetailed("02/01/2006")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(FormatDMY, r.Format, "Incorrect format")
+	assert.False(r.Ambiguity.Has(AmbiguousDateOrder), "Explicit date order is not ambiguous")
+
+	_, err = p.US("02/01/2006")
+	assert.NotNil(err, "Month first reading must be rejected with DateOrderDMY")
+}
+
+func TestDMY(test *testing.T) {
+	assert := assert.New(test)
+	p, _ := New(WithLocation(time.UTC))
+
+	t, err := p.DMY("02/01/2006 at 3:04pm")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2006, time.January, 2, 15, 4, 0, 0, time.UTC), t, "Parse error")
+
+	_, err = New(WithDateOrder(DateOrder(10)))
+	assert.NotNil(err, "Unknown date order must fail")
+}
+
+func TestDateOrderErrors(test *testing.T) {
+	assert := assert.New(test)
+
+	// the error of the configured date order, not of another reading
+	errs := []struct {
+		order  DateOrder
+		value  string
+		field  string
+		offset int
+	}{
+		{DateOrderDMY, "01/13/2006", "month", 3},
+		{DateOrderDMY, "13/13/2006", "month", 3},
+		{DateOrderMDY, "13/01/2006", "month", 0},
+		{DateOrderMDY, "01/32/2006", "day", 3},
+		{DateOrderYMD, "06/13/01", "month", 3},
+	}
+
+	for _, e := range errs {
+		p, _ := New(WithDateOrder(e.order))
+		_, err := p.Parse(e.value)
+		assert.True(errors.Is(err, ErrOutOfRange), "%s %s: %v", e.order, e.value, err)
+
+		var perr *ParseError
+		if assert.True(errors.As(err, &perr), e.value) {
+			assert.Equal(e.field, perr.Field, e.value)
+			assert.Equal(e.offset, perr.Offset, e.value)
+		}
+	}
+}
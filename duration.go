@@ -0,0 +1,191 @@
+package parsetime
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FormatDuration is the format name reported in duration errors
+const FormatDuration = "Duration"
+
+var reISO8601Duration = regexp.MustCompile(ISO8601Duration)
+
+// Duration is an ISO 8601 duration.
+// The calendar part (years, months, weeks, days) depends on the date it is
+// added to and is kept separate from the exact clock part.
+type Duration struct {
+	Years, Months, Weeks, Days int
+	// Clock is the hours, minutes and seconds
+	Clock time.Duration
+	// Negative reports a leading minus sign, -P1D
+	Negative bool
+
+	// loc is the location calendar arithmetic is done in
+	loc *time.Location
+}
+
+// AddTo returns t plus the duration.
+// Years, months, weeks and days are added to the date in the ParseTime
+// location, keeping the wall clock across DST changes, then the clock part
+// is added as elapsed time.
+func (d Duration) AddTo(t time.Time) time.Time {
+	sign := 1
+	if d.Negative {
+		sign = -1
+	}
+
+	u := t
+	if d.loc != nil {
+		u = t.In(d.loc)
+	}
+
+	u = u.AddDate(sign*d.Years, sign*d.Months, sign*(d.Weeks*7+d.Days))
+	u = u.Add(time.Duration(sign) * d.Clock)
+
+	return u.In(t.Location())
+}
+
+// String returns the duration in ISO 8601 format, e.g. P1Y2M10DT2H30M
+func (d Duration) String() string {
+	var b strings.Builder
+
+	if d.Negative {
+		b.WriteString("-")
+	}
+
+	b.WriteString("P")
+
+	for _, c := range []struct {
+		value      int
+		designator string
+	}{
+		{d.Years, "Y"},
+		{d.Months, "M"},
+		{d.Weeks, "W"},
+		{d.Days, "D"},
+	} {
+		if c.value != 0 {
+			b.WriteString(strconv.Itoa(c.value) + c.designator)
+		}
+	}
+
+	if d.Clock != 0 {
+		b.WriteString("T")
+
+		clock := d.Clock
+		if h := clock / time.Hour; h != 0 {
+			b.WriteString(strconv.FormatInt(int64(h), 10) + "H")
+			clock -= h * time.Hour
+		}
+
+		if m := clock / time.Minute; m != 0 {
+			b.WriteString(strconv.FormatInt(int64(m), 10) + "M")
+			clock -= m * time.Minute
+		}
+
+		if clock != 0 {
+			b.WriteString(strconv.FormatFloat(clock.Seconds(), 'f', -1, 64) + "S")
+		}
+	}
+
+	if b.Len() == len("P") || (d.Negative && b.Len() == len("-P")) {
+		return "PT0S"
+	}
+
+	return b.String()
+}
+
+// durationComponent parses one number of a duration
+type durationComponent struct {
+	capture
+	name string
+	// unit is the clock unit, 0 for calendar components
+	unit time.Duration
+}
+
+// ParseDuration parses an ISO 8601 duration: P1Y2M10DT2H30M, PT0.5S, P2W or -P1D.
+// Only the last component may have a fraction, and only hours, minutes or
+// seconds.
+func (pt *ParseTime) ParseDuration(value string) (Duration, error) {
+	n := pt.context().normalize(value)
+
+	d, err := parseDuration(n.value)
+	d.loc = pt.location
+
+	return d, n.restoreError(err)
+}
+
+func parseDuration(value string) (Duration, error) {
+	index := reISO8601Duration.FindStringSubmatchIndex(value)
+	if index == nil {
+		return Duration{}, noMatchError(value, FormatDuration)
+	}
+
+	group := submatches(value, index)
+
+	d := Duration{Negative: group[1].value == "-"}
+
+	components := []durationComponent{
+		{group[2], "years", 0},
+		{group[3], "months", 0},
+		{group[4], "weeks", 0},
+		{group[5], "days", 0},
+		{group[7], "hours", time.Hour},
+		{group[8], "minutes", time.Minute},
+		{group[9], "seconds", time.Second},
+	}
+
+	// P and PT alone are not durations
+	last := -1
+	for i, c := range components {
+		if c.value != "" {
+			last = i
+		}
+	}
+
+	if last < 0 || (group[6].value != "" && last < 4) {
+		return Duration{}, noMatchError(value, FormatDuration)
+	}
+
+	calendar := []*int{&d.Years, &d.Months, &d.Weeks, &d.Days}
+
+	for i, c := range components {
+		if c.value == "" {
+			continue
+		}
+
+		whole, frac := c.value, ""
+		if j := strings.IndexAny(c.value, ".,"); j >= 0 {
+			whole, frac = c.value[:j], c.value[j+1:]
+
+			if i != last || c.unit == 0 {
+				return Duration{}, newParseError(value, FormatDuration, c.capture, c.name, ErrInvalidDateTime)
+			}
+		}
+
+		v, err := strconv.ParseInt(whole, 10, 64)
+		if err != nil {
+			return Duration{}, newParseError(value, FormatDuration, c.capture, c.name, ErrOutOfRange)
+		}
+
+		if c.unit == 0 {
+			if v > math.MaxInt32 {
+				return Duration{}, newParseError(value, FormatDuration, c.capture, c.name, ErrOutOfRange)
+			}
+
+			*calendar[i] = int(v)
+			continue
+		}
+
+		if v > int64(math.MaxInt64/c.unit) || d.Clock > math.MaxInt64-time.Duration(v)*c.unit-c.unit {
+			return Duration{}, newParseError(value, FormatDuration, c.capture, c.name, ErrOutOfRange)
+		}
+
+		d.Clock += time.Duration(v)*c.unit + scaleFraction(frac, c.unit)
+	}
+
+	return d, nil
+}
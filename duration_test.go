@@ -0,0 +1,110 @@
+package parsetime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDuration(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := New(WithLocation(time.UTC))
+
+	tests := []struct {
+		value    string
+		expected Duration
+	}{
+		{"P1Y2M10DT2H30M", Duration{Years: 1, Months: 2, Days: 10, Clock: 2*time.Hour + 30*time.Minute}},
+		{"PT0.5S", Duration{Clock: 500 * time.Millisecond}},
+		{"PT0,5S", Duration{Clock: 500 * time.Millisecond}},
+		{"P2W", Duration{Weeks: 2}},
+		{"P1D", Duration{Days: 1}},
+		{"PT36H", Duration{Clock: 36 * time.Hour}},
+		{"PT1.5H", Duration{Clock: 90 * time.Minute}},
+		{"PT1M0.25S", Duration{Clock: time.Minute + 250*time.Millisecond}},
+		{"-P1D", Duration{Days: 1, Negative: true}},
+		{"+P1M", Duration{Months: 1}},
+		{"P0D", Duration{}},
+	}
+
+	for _, t := range tests {
+		d, err := p.ParseDuration(t.value)
+		assert.Equal(nil, err, t.value)
+
+		t.expected.loc = time.UTC
+		assert.Equal(t.expected, d, t.value)
+	}
+}
+
+func TestParseDurationErrors(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := New(WithLocation(time.UTC))
+
+	for _, value := range []string{"", "P", "PT", "P1DT", "1D", "P1H", "PT1D", "P1S", "2006-01-02"} {
+		_, err := p.ParseDuration(value)
+		assert.True(errors.Is(err, ErrInvalidDateTime), value)
+	}
+
+	_, err := p.ParseDuration("P1.5D")
+	assert.True(errors.Is(err, ErrInvalidDateTime), "fractional days")
+
+	_, err = p.ParseDuration("PT1.5H30M")
+	var perr *ParseError
+	if assert.True(errors.As(err, &perr), "fraction not in last component") {
+		assert.Equal("hours", perr.Field, "Incorrect field")
+		assert.Equal("1.5", perr.Value, "Incorrect value")
+		assert.Equal(2, perr.Offset, "Incorrect offset")
+	}
+
+	_, err = p.ParseDuration("PT99999999999999999999H")
+	assert.True(errors.Is(err, ErrOutOfRange), "overflow")
+
+	_, err = p.ParseDuration("PT9999999H")
+	assert.True(errors.Is(err, ErrOutOfRange), "overflow")
+}
+
+func TestDurationAddTo(test *testing.T) {
+	assert := assert.New(test)
+
+	loc, _ := time.LoadLocation("America/New_York")
+	p, _ := New(WithLocation(loc))
+
+	// the day before the DST change on 2006-04-02
+	start := time.Date(2006, time.April, 1, 12, 0, 0, 0, loc)
+
+	d, _ := p.ParseDuration("P1D")
+	assert.Equal(time.Date(2006, time.April, 2, 12, 0, 0, 0, loc), d.AddTo(start), "a day keeps the wall clock")
+
+	d, _ = p.ParseDuration("PT24H")
+	assert.Equal(time.Date(2006, time.April, 2, 13, 0, 0, 0, loc), d.AddTo(start), "24 hours is elapsed time")
+
+	d, _ = p.ParseDuration("P1M")
+	assert.Equal(time.Date(2006, time.May, 1, 12, 0, 0, 0, loc), d.AddTo(start), "Incorrect month")
+
+	d, _ = p.ParseDuration("-P1Y2M10DT2H30M")
+	assert.Equal(time.Date(2005, time.January, 22, 9, 30, 0, 0, loc), d.AddTo(start), "Incorrect negative duration")
+
+	// calendar arithmetic is done in the ParseTime location
+	utc := start.UTC()
+	d, _ = p.ParseDuration("P1D")
+	assert.Equal(time.Date(2006, time.April, 2, 16, 0, 0, 0, time.UTC), d.AddTo(utc), "Incorrect location")
+	assert.Equal(time.UTC, d.AddTo(utc).Location(), "location of t is kept")
+}
+
+func TestDurationString(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := New(WithLocation(time.UTC))
+
+	for _, value := range []string{"P1Y2M10DT2H30M", "PT0.5S", "P2W", "-P1D", "PT36H", "PT1M0.25S"} {
+		d, err := p.ParseDuration(value)
+		assert.Equal(nil, err, value)
+		assert.Equal(value, d.String(), value)
+	}
+
+	assert.Equal("PT0S", Duration{}.String(), "zero duration")
+}
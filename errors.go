@@ -0,0 +1,83 @@
+package parsetime
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Sentinel errors, matched with errors.Is
+var (
+	ErrInvalidDateTime = errors.New("Invalid date/time")
+	ErrInvalidOffset   = errors.New("Invalid offset")
+	ErrInvalidArgs     = errors.New("Invalid arguments")
+	ErrInvalidTimezone = errors.New("Invalid timezone")
+	ErrOutOfRange      = errors.New("Out of range")
+	ErrUnmatchedText   = errors.New("Unmatched text")
+	ErrMissingField    = errors.New("Missing field")
+	ErrWeekdayMismatch = errors.New("Weekday does not match date")
+)
+
+// ParseError describes a failure to parse a date/time string
+type ParseError struct {
+	// Input is the string being parsed
+	Input string
+	// Offset is the byte offset of the offending component in Input
+	Offset int
+	// Field names the offending component, e.g. "month" or "offset".
+	// It is empty when no format matched at all.
+	Field string
+	// Value is the text of the offending component
+	Value string
+	// Formats lists the formats attempted
+	Formats []string
+	// Err is the underlying cause
+	Err error
+}
+
+func (e *ParseError) Error() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "parsetime: parsing %q", e.Input)
+
+	if e.Field != "" {
+		fmt.Fprintf(&b, ": %s %q at byte %d", e.Field, e.Value, e.Offset)
+	}
+
+	if len(e.Formats) > 0 {
+		fmt.Fprintf(&b, " (tried %s)", strings.Join(e.Formats, ", "))
+	}
+
+	fmt.Fprintf(&b, ": %s", e.Err)
+
+	return b.String()
+}
+
+// Unwrap returns the underlying cause
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+func newParseError(input, format string, c capture, field string, err error) *ParseError {
+	var perr *ParseError
+	if errors.As(err, &perr) {
+		return perr
+	}
+
+	return &ParseError{
+		Input:   input,
+		Offset:  c.pos,
+		Field:   field,
+		Value:   c.value,
+		Formats: []string{format},
+		Err:     err,
+	}
+}
+
+func noMatchError(input string, formats ...string) *ParseError {
+	return &ParseError{
+		Input:   input,
+		Formats: formats,
+		Err:     ErrInvalidDateTime,
+	}
+}
@@ -0,0 +1,50 @@
+package parsetime
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseError(test *testing.T) {
+	assert := assert.New(test)
+	p, _ := NewParseTime()
+
+	_, err := p.RFC8xx1123("02-Jan-06 15:04 XYZABC")
+
+	var perr *ParseError
+	assert.True(errors.As(err, &perr), "Error must be a *ParseError")
+	assert.True(errors.Is(err, ErrInvalidOffset), "Error must wrap ErrInvalidOffset")
+	assert.Equal("02-Jan-06 15:04 XYZABC", perr.Input, "Incorrect input")
+	assert.Equal("offset", perr.Field, "Incorrect field")
+	assert.Equal("XYZABC", perr.Value, "Incorrect value")
+	assert.Equal(16, perr.Offset, "Incorrect offset")
+	assert.Equal([]string{FormatRFC8xx1123}, perr.Formats, "Incorrect formats")
+	assert.Equal(`parsetime: parsing "02-Jan-06 15:04 XYZABC": offset "XYZABC" at byte 16 (tried RFC8xx1123): Invalid offset`, err.Error())
+}
+
+func TestParseErrorNoMatch(test *testing.T) {
+	assert := assert.New(test)
+	p, _ := NewParseTime()
+
+	_, err := p.ANSIC("hello")
+
+	var perr *ParseError
+	assert.True(errors.As(err, &perr), "Error must be a *ParseError")
+	assert.True(errors.Is(err, ErrInvalidDateTime), "Error must wrap ErrInvalidDateTime")
+	assert.Equal("", perr.Field, "No field must be reported")
+
+	_, err = p.Unix("not an epoch")
+	assert.True(errors.Is(err, ErrInvalidDateTime), "Error must wrap ErrInvalidDateTime")
+}
+
+func TestNewInvalidArgs(test *testing.T) {
+	assert := assert.New(test)
+
+	_, err := New(WithLocation(nil))
+	assert.True(errors.Is(err, ErrInvalidArgs), "Error must be ErrInvalidArgs")
+
+	_, err = NewParseTime("MST", -7*3600, 0)
+	assert.True(errors.Is(err, ErrInvalidArgs), "Error must be ErrInvalidArgs")
+}
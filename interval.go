@@ -0,0 +1,306 @@
+package parsetime
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FormatInterval is the format name reported in interval errors
+const FormatInterval = "Interval"
+
+var reRecurrence = regexp.MustCompile(`^R([0-9]*)$`)
+
+// Interval is an ISO 8601 time interval, optionally repeating
+type Interval struct {
+	Start, End time.Time
+	// Duration is the duration of a start/duration or duration/end
+	// interval, nil for start/end
+	Duration *Duration
+	// Repeating reports an Rn/ prefix
+	Repeating bool
+	// Recurrences is the number of occurrences of a repeating interval,
+	// -1 if unbounded (R/)
+	Recurrences int
+
+	// fromEnd reports a duration/end interval, which repeats backwards
+	fromEnd bool
+}
+
+// ParseInterval parses an ISO 8601 time interval:
+// 2006-01-02T15:04Z/2006-01-05T00:00Z, 2006-01-02/P3D, P1D/2006-01-05 or
+// R5/2006-01-02T00:00Z/PT1H.
+// Components missing from the start of the end part are taken from the
+// start, e.g. 2006-01-02T10:00/12:00 or 2006-02-15/03-14, and so is the
+// offset.
+func (pt *ParseTime) ParseInterval(value string) (Interval, error) {
+	ctx := pt.context()
+	n := ctx.normalize(value)
+
+	i, err := parseInterval(n.value, ctx)
+	return i, n.restoreError(err)
+}
+
+func parseInterval(value string, ctx parseContext) (Interval, error) {
+	var parts []capture
+	pos := 0
+	for _, p := range strings.Split(value, "/") {
+		trimmed := strings.TrimSpace(p)
+		parts = append(parts, capture{value: trimmed, pos: pos + strings.Index(p, trimmed)})
+		pos += len(p) + 1
+	}
+
+	i := Interval{}
+
+	// R5/...
+	if m := reRecurrence.FindStringSubmatch(parts[0].value); m != nil {
+		i.Repeating, i.Recurrences = true, -1
+		if m[1] != "" {
+			n, err := strconv.Atoi(m[1])
+			if err != nil {
+				return Interval{}, newParseError(value, FormatInterval, parts[0], "recurrences", ErrOutOfRange)
+			}
+
+			i.Recurrences = n
+		}
+
+		parts = parts[1:]
+	}
+
+	if len(parts) != 2 {
+		return Interval{}, noMatchError(value, FormatInterval)
+	}
+
+	start, end := parts[0], parts[1]
+	isDuration := func(c capture) bool {
+		return strings.HasPrefix(strings.TrimLeft(c.value, "+-"), "P")
+	}
+
+	switch {
+	// P1D/P2D
+	case isDuration(start) && isDuration(end):
+		return Interval{}, noMatchError(value, FormatInterval)
+	// P1D/2006-01-05
+	case isDuration(start):
+		d, err := intervalDuration(value, start, ctx)
+		if err != nil {
+			return Interval{}, err
+		}
+
+		r, err := intervalTime(value, end, 0, ctx)
+		if err != nil {
+			return Interval{}, err
+		}
+
+		i.End, i.Duration, i.fromEnd = r.Time, &d, true
+		i.Start = d.scale(-1).AddTo(i.End)
+	default:
+		s, err := intervalTime(value, start, 0, ctx)
+		if err != nil {
+			return Interval{}, err
+		}
+
+		i.Start = s.Time
+
+		// 2006-01-02/P3D
+		if isDuration(end) {
+			d, err := intervalDuration(value, end, ctx)
+			if err != nil {
+				return Interval{}, err
+			}
+
+			i.End, i.Duration = d.AddTo(i.Start), &d
+			break
+		}
+
+		completed, prefix := inherit(start.value, end.value)
+		e, err := intervalTime(value, capture{value: completed, pos: end.pos}, prefix, ctx)
+		if err != nil {
+			return Interval{}, err
+		}
+
+		i.End = e.Time
+
+		// 2006-01-02T10:00Z/12:00 is 12:00Z
+		if s.ExplicitOffset && !e.ExplicitOffset {
+			year, month, day := e.Time.Date()
+			hour, min, sec := e.Time.Clock()
+			i.End = time.Date(year, month, day, hour, min, sec, e.Time.Nanosecond(), s.Time.Location())
+		}
+
+		if i.End.Before(i.Start) {
+			return Interval{}, newParseError(value, FormatInterval, end, "end", ErrOutOfRange)
+		}
+	}
+
+	return i, nil
+}
+
+// inherit completes an end part that omits the leading components of start
+// and returns the number of bytes taken from start:
+// 2006-01-02T10:00/12:00 -> 2006-01-02T12:00, 2006-02-15/03-14 -> 2006-03-14
+func inherit(start, end string) (string, int) {
+	startDate := start
+	if i := strings.IndexAny(start, "Tt"); i >= 0 {
+		startDate = start[:i]
+	}
+
+	// 12:00
+	if !strings.ContainsAny(end, "Tt") && strings.Contains(end, ":") {
+		return startDate + "T" + end, len(startDate) + 1
+	}
+
+	// T12:00
+	if strings.HasPrefix(end, "T") || strings.HasPrefix(end, "t") {
+		return startDate + end, len(startDate)
+	}
+
+	endDate := end
+	if i := strings.IndexAny(end, "Tt"); i >= 0 {
+		endDate = end[:i]
+	}
+
+	// 03-14
+	if len(endDate) < len(startDate) && endDate != "" && endDate[0] >= '0' && endDate[0] <= '9' {
+		prefix := len(startDate) - len(endDate)
+		return startDate[:prefix] + end, prefix
+	}
+
+	return end, 0
+}
+
+// intervalTime parses a date/time part of an interval at part.pos in
+// value; the first prefix bytes of part were inherited from the start
+func intervalTime(value string, part capture, prefix int, ctx parseContext) (ParseResult, error) {
+	// a zone abbreviation alone is not a date
+	if strings.IndexAny(part.value, "0123456789") != 0 && strings.IndexAny(part.value, "+-") != 0 {
+		return ParseResult{}, newParseError(value, FormatInterval, part, "time", ErrInvalidDateTime)
+	}
+
+	r, err := parseISO8601(part.value, ctx)
+	if err == nil {
+		err = checkStrict(r, part.value)
+	}
+
+	if err == nil {
+		return r, nil
+	}
+
+	var e *ParseError
+	if !errors.As(err, &e) || e.Field == "" || e.Offset < prefix {
+		whole := capture{value: part.value[prefix:], pos: part.pos}
+		return r, newParseError(value, FormatInterval, whole, "time", unwrapParseError(err))
+	}
+
+	shifted := *e
+	shifted.Input = value
+	shifted.Offset = part.pos + e.Offset - prefix
+
+	return r, &shifted
+}
+
+// intervalDuration parses a duration part of an interval at part.pos in value
+func intervalDuration(value string, part capture, ctx parseContext) (Duration, error) {
+	d, err := parseDuration(part.value)
+	d.loc = ctx.loc
+	if err == nil && d.Negative {
+		err = ErrOutOfRange
+	}
+
+	if err != nil {
+		return Duration{}, newParseError(value, FormatInterval, part, "duration", unwrapParseError(err))
+	}
+
+	return d, nil
+}
+
+// unwrapParseError returns the cause of a *ParseError
+func unwrapParseError(err error) error {
+	var e *ParseError
+	if errors.As(err, &e) {
+		return e.Err
+	}
+
+	return err
+}
+
+// scale returns the duration multiplied by n
+func (d Duration) scale(n int) Duration {
+	if n < 0 {
+		d.Negative = !d.Negative
+		n = -n
+	}
+
+	d.Years *= n
+	d.Months *= n
+	d.Weeks *= n
+	d.Days *= n
+	d.Clock *= time.Duration(n)
+
+	return d
+}
+
+// occurrence returns the start and end of the k-th occurrence, counting
+// from 0. Durations are multiplied rather than added repeatedly, so the
+// occurrences of 2006-01-31/P1M do not drift: the second starts on
+// March 3, as time.AddDate normalizes February 31, and the third on
+// March 31.
+func (i Interval) occurrence(k int) (time.Time, time.Time) {
+	switch {
+	case i.Duration != nil && i.fromEnd:
+		return i.Duration.scale(-k - 1).AddTo(i.End), i.Duration.scale(-k).AddTo(i.End)
+	case i.Duration != nil:
+		return i.Duration.scale(k).AddTo(i.Start), i.Duration.scale(k + 1).AddTo(i.Start)
+	}
+
+	step := i.End.Sub(i.Start)
+	return i.Start.Add(time.Duration(k) * step), i.Start.Add(time.Duration(k+1) * step)
+}
+
+// Iterate returns an iterator over the occurrences of the interval.
+// A non-repeating interval occurs once. A duration/end interval repeats
+// backwards from the end; the others repeat forwards from the start.
+func (i Interval) Iterate() *IntervalIterator {
+	return &IntervalIterator{interval: i}
+}
+
+// IntervalIterator steps through the occurrences of an interval
+//
+//	it := interval.Iterate()
+//	for it.Next() {
+//		fmt.Println(it.Start(), it.End())
+//	}
+type IntervalIterator struct {
+	interval   Interval
+	n          int
+	start, end time.Time
+}
+
+// Next advances to the next occurrence and reports whether there is one
+func (it *IntervalIterator) Next() bool {
+	count := 1
+	if it.interval.Repeating {
+		count = it.interval.Recurrences
+	}
+
+	if count >= 0 && it.n >= count {
+		return false
+	}
+
+	it.start, it.end = it.interval.occurrence(it.n)
+	it.n++
+
+	return true
+}
+
+// Start returns the start of the current occurrence
+func (it *IntervalIterator) Start() time.Time {
+	return it.start
+}
+
+// End returns the end of the current occurrence
+func (it *IntervalIterator) End() time.Time {
+	return it.end
+}
@@ -0,0 +1,162 @@
+package parsetime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseInterval(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := New(WithLocation(time.UTC))
+	mst := time.FixedZone("", -7*3600)
+
+	tests := []struct {
+		value      string
+		start, end time.Time
+	}{
+		{"2006-01-02T15:04Z/2006-01-05T00:00Z", time.Date(2006, time.January, 2, 15, 4, 0, 0, time.UTC), time.Date(2006, time.January, 5, 0, 0, 0, 0, time.UTC)},
+		{"2006-01-02/P3D", time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC), time.Date(2006, time.January, 5, 0, 0, 0, 0, time.UTC)},
+		{"P1D/2006-01-05", time.Date(2006, time.January, 4, 0, 0, 0, 0, time.UTC), time.Date(2006, time.January, 5, 0, 0, 0, 0, time.UTC)},
+		{"2006-01-02T10:00/12:00", time.Date(2006, time.January, 2, 10, 0, 0, 0, time.UTC), time.Date(2006, time.January, 2, 12, 0, 0, 0, time.UTC)},
+		{"2006-01-02T10:00/T12:30", time.Date(2006, time.January, 2, 10, 0, 0, 0, time.UTC), time.Date(2006, time.January, 2, 12, 30, 0, 0, time.UTC)},
+		{"2006-02-15/03-14", time.Date(2006, time.February, 15, 0, 0, 0, 0, time.UTC), time.Date(2006, time.March, 14, 0, 0, 0, 0, time.UTC)},
+		{"2006-01-02/05", time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC), time.Date(2006, time.January, 5, 0, 0, 0, 0, time.UTC)},
+		{"2006-01-02T10:00-07:00/12:00", time.Date(2006, time.January, 2, 10, 0, 0, 0, mst), time.Date(2006, time.January, 2, 12, 0, 0, 0, mst)},
+		{"2006-01-02T10:00-07:00/02T12:00", time.Date(2006, time.January, 2, 10, 0, 0, 0, mst), time.Date(2006, time.January, 2, 12, 0, 0, 0, mst)},
+		{" 2006-01-02 / 2006-01-03 ", time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC), time.Date(2006, time.January, 3, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, t := range tests {
+		i, err := p.ParseInterval(t.value)
+		if !assert.Equal(nil, err, t.value) {
+			continue
+		}
+
+		assert.True(t.start.Equal(i.Start), "%s: start %s", t.value, i.Start)
+		assert.True(t.end.Equal(i.End), "%s: end %s", t.value, i.End)
+		assert.False(i.Repeating, t.value)
+	}
+}
+
+func TestParseIntervalRepeating(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := New(WithLocation(time.UTC))
+
+	i, err := p.ParseInterval("R5/2006-01-02T00:00Z/PT1H")
+	assert.Equal(nil, err)
+	assert.True(i.Repeating)
+	assert.Equal(5, i.Recurrences)
+	assert.Equal(time.Hour, i.Duration.Clock)
+
+	i, err = p.ParseInterval("R/2006-01-02/2006-01-03")
+	assert.Equal(nil, err)
+	assert.Equal(-1, i.Recurrences)
+	assert.Nil(i.Duration)
+}
+
+func TestParseIntervalErrors(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := New(WithLocation(time.UTC))
+
+	tests := []struct {
+		value  string
+		err    error
+		offset int
+		text   string
+	}{
+		{"2006-01-02", ErrInvalidDateTime, 0, ""},
+		{"P1D/P2D", ErrInvalidDateTime, 0, ""},
+		{"R5/2006-01-02", ErrInvalidDateTime, 0, ""},
+		{"2006-01-02/2006-01-01", ErrOutOfRange, 11, "2006-01-01"},
+		{"2006-01-02/-P1D", ErrOutOfRange, 11, "-P1D"},
+		{"2006-01-02/P1.5D", ErrInvalidDateTime, 11, "P1.5D"},
+		{"2006-01-02/2006-01-03 x", ErrUnmatchedText, 22, "x"},
+		{"2006-01-02/foo", ErrInvalidDateTime, 11, "foo"},
+		{"15:04/2006-01-02", ErrMissingField, 0, ""},
+	}
+
+	for _, t := range tests {
+		_, err := p.ParseInterval(t.value)
+		assert.True(errors.Is(err, t.err), "%s: %v", t.value, err)
+
+		var perr *ParseError
+		if t.text != "" && assert.True(errors.As(err, &perr), t.value) {
+			assert.Equal(t.value, perr.Input, t.value)
+			assert.Equal(t.offset, perr.Offset, t.value)
+			assert.Equal(t.text, perr.Value, t.value)
+		}
+	}
+}
+
+func TestIntervalIterate(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := New(WithLocation(time.UTC))
+
+	collect := func(value string, limit int) []time.Time {
+		i, err := p.ParseInterval(value)
+		assert.Equal(nil, err, value)
+
+		var starts []time.Time
+		it := i.Iterate()
+		for len(starts) < limit && it.Next() {
+			starts = append(starts, it.Start())
+			assert.True(it.End().After(it.Start()), value)
+		}
+
+		return starts
+	}
+
+	day := func(month time.Month, d, hour int) time.Time {
+		return time.Date(2006, month, d, hour, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		value    string
+		expected []time.Time
+	}{
+		{"2006-01-02/P1D", []time.Time{day(1, 2, 0)}},
+		{"R3/2006-01-02T00:00Z/PT1H", []time.Time{day(1, 2, 0), day(1, 2, 1), day(1, 2, 2)}},
+		{"R0/2006-01-02T00:00Z/PT1H", nil},
+		{"R3/2006-01-02/2006-01-03", []time.Time{day(1, 2, 0), day(1, 3, 0), day(1, 4, 0)}},
+		{"R3/2006-01-31/P1M", []time.Time{day(1, 31, 0), day(3, 3, 0), day(3, 31, 0)}},
+		{"R3/P1D/2006-01-05", []time.Time{day(1, 4, 0), day(1, 3, 0), day(1, 2, 0)}},
+		{"R/2006-01-02/P1W", []time.Time{day(1, 2, 0), day(1, 9, 0), day(1, 16, 0), day(1, 23, 0)}},
+	}
+
+	for _, t := range tests {
+		starts := collect(t.value, 4)
+		assert.Equal(len(t.expected), len(starts), t.value)
+		for k := range t.expected {
+			if k < len(starts) {
+				assert.True(t.expected[k].Equal(starts[k]), "%s: %d %s", t.value, k, starts[k])
+			}
+		}
+	}
+}
+
+func TestIntervalIterateDST(test *testing.T) {
+	assert := assert.New(test)
+
+	loc, _ := time.LoadLocation("America/New_York")
+	p, _ := New(WithLocation(loc))
+
+	i, _ := p.ParseInterval("R3/2006-04-01T12:00/P1D")
+	it := i.Iterate()
+
+	var starts []time.Time
+	for it.Next() {
+		starts = append(starts, it.Start())
+	}
+
+	// a calendar day keeps 12:00 across the DST change on 2006-04-02
+	for k, s := range starts {
+		assert.Equal(time.Date(2006, time.April, 1+k, 12, 0, 0, 0, loc), s, "Incorrect occurrence")
+	}
+}
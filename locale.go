@@ -0,0 +1,463 @@
+package parsetime
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Locale holds the words of a language used in date/time strings.
+// English is always recognized; locales add to it.
+type Locale struct {
+	// Name is the language code, e.g. "fr"
+	Name string
+	// Months maps month names and abbreviations to 1-12
+	Months map[string]int
+	// Weekdays maps weekday names and abbreviations to time.Weekday
+	Weekdays map[string]time.Weekday
+	// AM and PM are the 12-hour clock markers, e.g. "a. m."
+	AM, PM []string
+	// Connectors are the words between the date and the time, e.g. "à" or "um"
+	Connectors []string
+	// DateWords are the words between the day, month and year, e.g. "de"
+	DateWords []string
+}
+
+// Built-in locales
+var (
+	LocaleFrench = &Locale{
+		Name: "fr",
+		Months: map[string]int{
+			"janvier":   1,
+			"janv":      1,
+			"février":   2,
+			"fevrier":   2,
+			"févr":      2,
+			"fevr":      2,
+			"mars":      3,
+			"avril":     4,
+			"avr":       4,
+			"mai":       5,
+			"juin":      6,
+			"juillet":   7,
+			"juil":      7,
+			"août":      8,
+			"aout":      8,
+			"septembre": 9,
+			"sept":      9,
+			"octobre":   10,
+			"oct":       10,
+			"novembre":  11,
+			"nov":       11,
+			"décembre":  12,
+			"decembre":  12,
+			"déc":       12,
+		},
+		Weekdays: map[string]time.Weekday{
+			"dimanche": time.Sunday,
+			"dim":      time.Sunday,
+			"lundi":    time.Monday,
+			"lun":      time.Monday,
+			"mardi":    time.Tuesday,
+			"mar":      time.Tuesday,
+			"mercredi": time.Wednesday,
+			"mer":      time.Wednesday,
+			"jeudi":    time.Thursday,
+			"jeu":      time.Thursday,
+			"vendredi": time.Friday,
+			"ven":      time.Friday,
+			"samedi":   time.Saturday,
+			"sam":      time.Saturday,
+		},
+		Connectors: []string{"à"},
+	}
+
+	LocaleGerman = &Locale{
+		Name: "de",
+		Months: map[string]int{
+			"Januar":    1,
+			"Jänner":    1,
+			"Jan":       1,
+			"Februar":   2,
+			"Feb":       2,
+			"März":      3,
+			"Maerz":     3,
+			"Mär":       3,
+			"April":     4,
+			"Apr":       4,
+			"Mai":       5,
+			"Juni":      6,
+			"Jun":       6,
+			"Juli":      7,
+			"Jul":       7,
+			"August":    8,
+			"Aug":       8,
+			"September": 9,
+			"Sep":       9,
+			"Sept":      9,
+			"Oktober":   10,
+			"Okt":       10,
+			"November":  11,
+			"Nov":       11,
+			"Dezember":  12,
+			"Dez":       12,
+		},
+		Weekdays: map[string]time.Weekday{
+			"Sonntag":    time.Sunday,
+			"So":         time.Sunday,
+			"Montag":     time.Monday,
+			"Mo":         time.Monday,
+			"Dienstag":   time.Tuesday,
+			"Di":         time.Tuesday,
+			"Mittwoch":   time.Wednesday,
+			"Mi":         time.Wednesday,
+			"Donnerstag": time.Thursday,
+			"Do":         time.Thursday,
+			"Freitag":    time.Friday,
+			"Fr":         time.Friday,
+			"Samstag":    time.Saturday,
+			"Sonnabend":  time.Saturday,
+			"Sa":         time.Saturday,
+		},
+		Connectors: []string{"um"},
+	}
+
+	LocaleSpanish = &Locale{
+		Name: "es",
+		Months: map[string]int{
+			"enero":      1,
+			"ene":        1,
+			"febrero":    2,
+			"feb":        2,
+			"marzo":      3,
+			"mar":        3,
+			"abril":      4,
+			"abr":        4,
+			"mayo":       5,
+			"may":        5,
+			"junio":      6,
+			"jun":        6,
+			"julio":      7,
+			"jul":        7,
+			"agosto":     8,
+			"ago":        8,
+			"septiembre": 9,
+			"setiembre":  9,
+			"sept":       9,
+			"sep":        9,
+			"set":        9,
+			"octubre":    10,
+			"oct":        10,
+			"noviembre":  11,
+			"nov":        11,
+			"diciembre":  12,
+			"dic":        12,
+		},
+		Weekdays: map[string]time.Weekday{
+			"domingo":   time.Sunday,
+			"dom":       time.Sunday,
+			"lunes":     time.Monday,
+			"lun":       time.Monday,
+			"martes":    time.Tuesday,
+			"mar":       time.Tuesday,
+			"miércoles": time.Wednesday,
+			"miercoles": time.Wednesday,
+			"mié":       time.Wednesday,
+			"mie":       time.Wednesday,
+			"jueves":    time.Thursday,
+			"jue":       time.Thursday,
+			"viernes":   time.Friday,
+			"vie":       time.Friday,
+			"sábado":    time.Saturday,
+			"sabado":    time.Saturday,
+			"sáb":       time.Saturday,
+			"sab":       time.Saturday,
+		},
+		AM:         []string{"a. m."},
+		PM:         []string{"p. m."},
+		Connectors: []string{"a las", "a la"},
+		DateWords:  []string{"de", "del"},
+	}
+
+	LocalePortuguese = &Locale{
+		Name: "pt",
+		Months: map[string]int{
+			"janeiro":   1,
+			"jan":       1,
+			"fevereiro": 2,
+			"fev":       2,
+			"março":     3,
+			"marco":     3,
+			"mar":       3,
+			"abril":     4,
+			"abr":       4,
+			"maio":      5,
+			"mai":       5,
+			"junho":     6,
+			"jun":       6,
+			"julho":     7,
+			"jul":       7,
+			"agosto":    8,
+			"ago":       8,
+			"setembro":  9,
+			"set":       9,
+			"outubro":   10,
+			"out":       10,
+			"novembro":  11,
+			"nov":       11,
+			"dezembro":  12,
+			"dez":       12,
+		},
+		Weekdays: map[string]time.Weekday{
+			"domingo":       time.Sunday,
+			"dom":           time.Sunday,
+			"segunda-feira": time.Monday,
+			"segunda":       time.Monday,
+			"seg":           time.Monday,
+			"terça-feira":   time.Tuesday,
+			"terca-feira":   time.Tuesday,
+			"terça":         time.Tuesday,
+			"terca":         time.Tuesday,
+			"ter":           time.Tuesday,
+			"quarta-feira":  time.Wednesday,
+			"quarta":        time.Wednesday,
+			"qua":           time.Wednesday,
+			"quinta-feira":  time.Thursday,
+			"quinta":        time.Thursday,
+			"qui":           time.Thursday,
+			"sexta-feira":   time.Friday,
+			"sexta":         time.Friday,
+			"sex":           time.Friday,
+			"sábado":        time.Saturday,
+			"sabado":        time.Saturday,
+			"sáb":           time.Saturday,
+			"sab":           time.Saturday,
+		},
+		Connectors: []string{"às", "as"},
+		DateWords:  []string{"de"},
+	}
+
+	LocaleJapanese = &Locale{
+		Name: "ja",
+		Months: map[string]int{
+			"1月":  1,
+			"2月":  2,
+			"3月":  3,
+			"4月":  4,
+			"5月":  5,
+			"6月":  6,
+			"7月":  7,
+			"8月":  8,
+			"9月":  9,
+			"10月": 10,
+			"11月": 11,
+			"12月": 12,
+		},
+		Weekdays: map[string]time.Weekday{
+			"日曜日": time.Sunday,
+			"日曜":  time.Sunday,
+			"日":   time.Sunday,
+			"月曜日": time.Monday,
+			"月曜":  time.Monday,
+			"月":   time.Monday,
+			"火曜日": time.Tuesday,
+			"火曜":  time.Tuesday,
+			"火":   time.Tuesday,
+			"水曜日": time.Wednesday,
+			"水曜":  time.Wednesday,
+			"水":   time.Wednesday,
+			"木曜日": time.Thursday,
+			"木曜":  time.Thursday,
+			"木":   time.Thursday,
+			"金曜日": time.Friday,
+			"金曜":  time.Friday,
+			"金":   time.Friday,
+			"土曜日": time.Saturday,
+			"土曜":  time.Saturday,
+			"土":   time.Saturday,
+		},
+		AM: []string{"午前"},
+		PM: []string{"午後"},
+	}
+)
+
+var builtinLocales = []*Locale{LocaleFrench, LocaleGerman, LocaleSpanish, LocalePortuguese, LocaleJapanese}
+
+// LookupLocale returns the built-in locale with the given name, e.g. "fr"
+func LookupLocale(name string) (*Locale, bool) {
+	for _, l := range builtinLocales {
+		if strings.EqualFold(l.Name, name) {
+			return l, true
+		}
+	}
+
+	return nil, false
+}
+
+func (l *Locale) month(name string) (int, bool) {
+	for k, v := range l.Months {
+		if strings.EqualFold(k, name) {
+			return v, true
+		}
+	}
+
+	return 0, false
+}
+
+func (l *Locale) weekday(name string) (time.Weekday, bool) {
+	for k, v := range l.Weekdays {
+		if strings.EqualFold(k, name) {
+			return v, true
+		}
+	}
+
+	return 0, false
+}
+
+// alternation quotes words for a regular expression, longest first so that
+// "septembre" is preferred to "sept"
+func alternation(words []string) string {
+	quoted := make([]string, 0, len(words))
+	for _, w := range words {
+		quoted = append(quoted, regexp.QuoteMeta(w))
+	}
+
+	// sorted so map keys compile to the same pattern
+	sort.Strings(quoted)
+	sort.SliceStable(quoted, func(i, j int) bool {
+		return len(quoted[i]) > len(quoted[j])
+	})
+
+	return strings.Join(quoted, "|")
+}
+
+// localeWords returns the English fragments extended with the words of the locales
+func localeWords(locales []*Locale) words {
+	var weekdays, months, ampms, connectors, dateWords []string
+
+	for _, l := range locales {
+		for k := range l.Weekdays {
+			weekdays = append(weekdays, k)
+		}
+		for k := range l.Months {
+			months = append(months, k)
+		}
+		ampms = append(ampms, l.AM...)
+		ampms = append(ampms, l.PM...)
+		connectors = append(connectors, l.Connectors...)
+		dateWords = append(dateWords, l.DateWords...)
+	}
+
+	w := englishWords
+
+	// one longest-first alternation with the English names,
+	// so that "Montag" is not read as "Mon"
+	if len(weekdays) > 0 {
+		for k := range Weekdays {
+			weekdays = append(weekdays, k)
+		}
+
+		w.weekday = `((?i:` + alternation(weekdays) + `)[.]?)`
+	}
+
+	if len(months) > 0 {
+		for k := range Months {
+			months = append(months, k)
+		}
+
+		w.month = `((?i:` + alternation(months) + `)[.]?|1[012]|0?[1-9])`
+	}
+
+	if len(ampms) > 0 {
+		w.ampm = `([aApP][.]?[mM][.]?|(?i:` + alternation(ampms) + `))`
+	}
+
+	if len(connectors) > 0 {
+		w.connector = `(?:at|(?i:` + alternation(connectors) + `))?`
+	}
+
+	// 2. Januar 2006, 2 de enero de 2006
+	w.dateSep = `(?:[/-]|[.]?\s*)`
+	if len(dateWords) > 0 {
+		w.dateSep = `(?:[/-]|[.]?\s*(?:(?i:` + alternation(dateWords) + `)\s+)?)`
+	}
+
+	return w
+}
+
+// patternSet holds the compiled formats that contain month, weekday or AM/PM names
+type patternSet struct {
+	rfc8xx1123, ansic, us, dmy, ymd                               *regexp.Regexp
+	strictRFC8xx1123, strictANSIC, strictUS, strictDMY, strictYMD *regexp.Regexp
+}
+
+func compilePatterns(w words) *patternSet {
+	return &patternSet{
+		rfc8xx1123:       regexp.MustCompile(w.rfc8xx1123()),
+		ansic:            regexp.MustCompile(w.ansic()),
+		us:               regexp.MustCompile(w.us()),
+		dmy:              regexp.MustCompile(w.dmy()),
+		ymd:              regexp.MustCompile(w.ymd()),
+		strictRFC8xx1123: anchor(w.rfc8xx1123()),
+		strictANSIC:      anchor(w.ansic()),
+		strictUS:         anchor(w.us()),
+		strictDMY:        anchor(w.dmy()),
+		strictYMD:        anchor(w.ymd()),
+	}
+}
+
+// monthNumber looks up an English or locale month name
+func (ctx parseContext) monthNumber(name string) (int, bool) {
+	if n, ok := monthNumber(name); ok {
+		return n, true
+	}
+
+	name = strings.TrimSuffix(name, ".")
+	for _, l := range ctx.locales {
+		if n, ok := l.month(name); ok {
+			return n, true
+		}
+	}
+
+	return 0, false
+}
+
+// weekdayNumber looks up an English, CJK or locale weekday name
+func (ctx parseContext) weekdayNumber(name string) (time.Weekday, bool) {
+	if d, ok := weekdayNumber(name); ok {
+		return d, true
+	}
+
+	if d, ok := cjkWeekdayNumber(name); ok {
+		return d, true
+	}
+
+	name = strings.TrimSuffix(name, ".")
+	for _, l := range ctx.locales {
+		if d, ok := l.weekday(name); ok {
+			return d, true
+		}
+	}
+
+	return 0, false
+}
+
+// meridiem converts a locale AM/PM marker to "AM" or "PM"
+func (ctx parseContext) meridiem(value string) string {
+	for _, l := range ctx.locales {
+		for _, am := range l.AM {
+			if strings.EqualFold(am, value) {
+				return "AM"
+			}
+		}
+
+		for _, pm := range l.PM {
+			if strings.EqualFold(pm, value) {
+				return "PM"
+			}
+		}
+	}
+
+	return value
+}
@@ -0,0 +1,110 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocales(test *testing.T) {
+	assert := assert.New(test)
+
+	p, err := New(WithFixedZone("UTC", 0), WithLocales(LocaleFrench, LocaleGerman, LocaleSpanish, LocalePortuguese))
+	assert.Equal(nil, err, "Invalid options")
+	assert.Equal(4, len(p.GetLocales()), "Incorrect locales")
+
+	date := time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC)
+	datetime := time.Date(2006, time.January, 2, 15, 4, 0, 0, time.UTC)
+
+	tests := map[string]time.Time{
+		"2 janvier 2006":                      date,
+		"lundi 2 janvier 2006":                date,
+		"2 janvier 2006 à 15:04":              datetime,
+		"2 févr. 2006":                        time.Date(2006, time.February, 2, 0, 0, 0, 0, time.UTC),
+		"2. Januar 2006":                      date,
+		"Montag, 2. Januar 2006 um 15:04":     datetime,
+		"2. MÄRZ 2006":                        time.Date(2006, time.March, 2, 0, 0, 0, 0, time.UTC),
+		"lunes, 2 de enero de 2006":           date,
+		"2 de enero de 2006 a las 3:04 p. m.": datetime,
+		"segunda-feira, 2 de janeiro de 2006": date,
+		"2 de janeiro de 2006 às 15:04":       datetime,
+		"Mon, 02 Jan 2006 15:04:00 +0000":     datetime,
+		"January 2, 2006":                     date,
+	}
+
+	for in, expected := range tests {
+		t, err := p.Parse(in)
+		assert.Equal(nil, err, in)
+		assert.True(expected.Equal(t), "%s: %s", in, t)
+	}
+}
+
+func TestLocaleWeekdayCheck(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := New(WithFixedZone("UTC", 0), WithLocales(LocaleSpanish))
+
+	r, err := p.ParseDetailed("martes, 2 de enero de 2006")
+	assert.Equal(nil, err, "mismatched weekday is a warning")
+	assert.Equal(1, len(r.Warnings), "Incorrect warnings")
+
+	r, err = p.ParseDetailed("lunes, 2 de enero de 2006")
+	assert.Equal(nil, err, "matching weekday")
+	assert.Equal(0, len(r.Warnings), "Incorrect warnings")
+}
+
+func TestLocalesNotConfigured(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := New(WithFixedZone("UTC", 0), WithStrict())
+
+	_, err := p.Parse("2 janvier 2006")
+	assert.NotNil(err, "French is not recognized without the locale")
+
+	p.SetLocales(LocaleFrench)
+	_, err = p.Parse("2 janvier 2006")
+	assert.Equal(nil, err, "French is recognized with the locale")
+
+	p.SetLocales()
+	_, err = p.Parse("2 janvier 2006")
+	assert.NotNil(err, "no locale restores English only")
+}
+
+func TestLookupLocale(test *testing.T) {
+	assert := assert.New(test)
+
+	for _, name := range []string{"fr", "de", "es", "pt", "ja"} {
+		l, ok := LookupLocale(name)
+		assert.True(ok, name)
+		assert.Equal(name, l.Name, name)
+	}
+
+	_, ok := LookupLocale("xx")
+	assert.False(ok, "unknown locale")
+
+	_, err := New(WithLocales(nil))
+	assert.NotNil(err, "nil locale must fail")
+}
+
+func TestLocaleNames(test *testing.T) {
+	assert := assert.New(test)
+
+	ctx := parseContext{locales: []*Locale{LocaleJapanese, LocaleGerman}}
+
+	month, ok := ctx.monthNumber("12月")
+	assert.True(ok, "Japanese month")
+	assert.Equal(12, month, "Japanese month")
+
+	month, ok = ctx.monthNumber("dez.")
+	assert.True(ok, "German month")
+	assert.Equal(12, month, "German month")
+
+	weekday, ok := ctx.weekdayNumber("月曜日")
+	assert.True(ok, "Japanese weekday")
+	assert.Equal(time.Monday, weekday, "Japanese weekday")
+
+	assert.Equal("PM", ctx.meridiem("午後"), "Japanese PM")
+	assert.Equal("AM", ctx.meridiem("午前"), "Japanese AM")
+	assert.Equal("pm", ctx.meridiem("pm"), "English pm")
+}
@@ -0,0 +1,34 @@
+package parsetime
+
+import (
+	"strings"
+	"time"
+)
+
+// monthNumber looks up a month name case-insensitively, ignoring a trailing dot
+// (JAN, jan., Sept)
+func monthNumber(name string) (int, bool) {
+	name = strings.TrimSuffix(name, ".")
+
+	for k, v := range Months {
+		if strings.EqualFold(k, name) {
+			return v, true
+		}
+	}
+
+	return 0, false
+}
+
+// weekdayNumber looks up a weekday name case-insensitively, ignoring a trailing dot
+// (MON, tues., Thurs)
+func weekdayNumber(name string) (time.Weekday, bool) {
+	name = strings.TrimSuffix(name, ".")
+
+	for k, v := range Weekdays {
+		if strings.EqualFold(k, name) {
+			return v, true
+		}
+	}
+
+	return 0, false
+}
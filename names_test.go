@@ -0,0 +1,146 @@
+package parsetime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMonthNumber(test *testing.T) {
+	assert := assert.New(test)
+
+	tests := map[string]int{
+		"Jan":      1,
+		"JAN":      1,
+		"jan.":     1,
+		"February": 2,
+		"Sept":     9,
+		"sept.":    9,
+		"DECEMBER": 12,
+	}
+
+	for name, expected := range tests {
+		month, ok := monthNumber(name)
+		assert.True(ok, name)
+		assert.Equal(expected, month, name)
+	}
+
+	_, ok := monthNumber("Februray")
+	assert.False(ok, "misspelling must not match")
+}
+
+func TestWeekdayNumber(test *testing.T) {
+	assert := assert.New(test)
+
+	tests := map[string]time.Weekday{
+		"Mon":    time.Monday,
+		"TUES.":  time.Tuesday,
+		"thurs":  time.Thursday,
+		"Sunday": time.Sunday,
+	}
+
+	for name, expected := range tests {
+		weekday, ok := weekdayNumber(name)
+		assert.True(ok, name)
+		assert.Equal(expected, weekday, name)
+	}
+}
+
+func TestCaseInsensitiveNames(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := New(WithFixedZone("UTC", 0))
+	expected := time.Date(2006, time.February, 2, 0, 0, 0, 0, time.UTC)
+
+	for _, in := range []string{
+		"February 2, 2006",
+		"FEBRUARY 2, 2006",
+		"feb. 2, 2006",
+		"Thursday, February 2, 2006",
+		"THU, 02 FEB 2006 00:00:00 +0000",
+		"thu feb 02 00:00:00 2006",
+		"2 Feb. 2006",
+	} {
+		t, err := p.Parse(in)
+		assert.Equal(nil, err, in)
+		assert.True(expected.Equal(t), in)
+	}
+
+	t, err := p.Parse("Sept 5, 2006")
+	assert.Equal(nil, err, "Sept")
+	assert.Equal(time.September, t.Month(), "Sept")
+}
+
+func TestWeekdayCheck(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := New(WithFixedZone("UTC", 0))
+
+	// lenient by default
+	_, err := p.Parse("Tue, 02 Jan 2006 15:04:05 +0000")
+	assert.Equal(nil, err, "weekday is not checked by default")
+
+	p, err = New(WithFixedZone("UTC", 0), WithWeekdayCheck())
+	assert.Equal(nil, err, "Invalid options")
+	assert.True(p.GetWeekdayCheck(), "Incorrect weekday check")
+
+	_, err = p.Parse("Mon, 02 Jan 2006 15:04:05 +0000")
+	assert.Equal(nil, err, "matching weekday")
+
+	_, err = p.Parse("Tue, 02 Jan 2006 15:04:05 +0000")
+	assert.True(errors.Is(err, ErrWeekdayMismatch), "mismatched weekday")
+
+	var perr *ParseError
+	if assert.True(errors.As(err, &perr)) {
+		assert.Equal("weekday", perr.Field, "Incorrect field")
+		assert.Equal("Tue", perr.Value, "Incorrect value")
+	}
+
+	_, err = p.ANSIC("Fri Jan 02 15:04:05 2006")
+	assert.True(errors.Is(err, ErrWeekdayMismatch), "mismatched ANSIC weekday")
+
+	_, err = p.US("Tuesday, January 2, 2006")
+	assert.True(errors.Is(err, ErrWeekdayMismatch), "mismatched US weekday")
+
+	p.SetWeekdayCheck(false)
+	_, err = p.Parse("Tue, 02 Jan 2006 15:04:05 +0000")
+	assert.Equal(nil, err, "weekday check disabled")
+}
+
+func TestWeekdayMismatchWarning(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := New(WithFixedZone("UTC", 0))
+
+	for _, in := range []string{
+		"Fri, 02 Jan 2006 15:04:05 +0000",
+		"Fri Jan 02 15:04:05 2006",
+	} {
+		r, err := p.ParseDetailed(in)
+		assert.Equal(nil, err, in)
+		assert.Equal(2, r.Time.Day(), in)
+		if assert.Equal(1, len(r.Warnings), in) {
+			assert.True(errors.Is(r.Warnings[0], ErrWeekdayMismatch), in)
+
+			var perr *ParseError
+			if assert.True(errors.As(r.Warnings[0], &perr), in) {
+				assert.Equal("weekday", perr.Field, in)
+				assert.Equal("Fri", perr.Value, in)
+				assert.Equal(0, perr.Offset, in)
+			}
+		}
+	}
+
+	r, err := p.ParseDetailed("Mon, 02 Jan 2006 15:04:05 +0000")
+	assert.Equal(nil, err, "matching weekday")
+	assert.Equal(0, len(r.Warnings), "matching weekday has no warning")
+
+	p.SetStrict(true)
+	_, err = p.Parse("Fri, 02 Jan 2006 15:04:05 +0000")
+	assert.True(errors.Is(err, ErrWeekdayMismatch), "strict mode rejects mismatched weekday")
+
+	_, err = p.Parse("Mon, 02 Jan 2006 15:04:05 +0000")
+	assert.Equal(nil, err, "strict mode accepts matching weekday")
+}
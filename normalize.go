@@ -0,0 +1,120 @@
+package parsetime
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+// foldRune maps full-width ASCII, Unicode dashes and minus signs, non-ASCII
+// spaces and colon look-alikes to ASCII
+func foldRune(r rune) rune {
+	switch {
+	// full-width ASCII: ２００６－０１－０２ -> 2006-01-02
+	case r >= '\uff01' && r <= '\uff5e':
+		return r - '\uff01' + '!'
+	// no-break, en/em, thin, hair, narrow no-break, ideographic spaces
+	case r == '\u00a0', r >= '\u2000' && r <= '\u200a', r == '\u202f', r == '\u205f', r == '\u3000':
+		return ' '
+	// hyphens, figure dash, en/em dashes, minus sign, small hyphen-minus
+	case r >= '\u2010' && r <= '\u2015', r == '\u2212', r == '\ufe63':
+		return '-'
+	// ratio, small colon, modifier letter colon
+	case r == '\u2236', r == '\ufe55', r == '\ua789':
+		return ':'
+	}
+
+	return r
+}
+
+// normalized is an input with folded characters and the byte offsets to
+// map positions in value back to input
+type normalized struct {
+	input, value string
+	// offsets[i] is the byte offset in input of byte i of value,
+	// nil when nothing was folded
+	offsets []int
+}
+
+func normalize(input string) normalized {
+	n := normalized{input: input, value: input}
+
+	if strings.IndexFunc(input, func(r rune) bool { return foldRune(r) != r }) < 0 {
+		return n
+	}
+
+	var b strings.Builder
+	n.offsets = make([]int, 0, len(input)+1)
+
+	for i, r := range input {
+		f := foldRune(r)
+		b.WriteRune(f)
+
+		for j := 0; j < utf8.RuneLen(f); j++ {
+			n.offsets = append(n.offsets, i)
+		}
+	}
+
+	n.value = b.String()
+	n.offsets = append(n.offsets, len(input))
+
+	return n
+}
+
+// offset maps a byte offset in value to input
+func (n normalized) offset(i int) int {
+	if n.offsets == nil || i < 0 || i >= len(n.offsets) {
+		return i
+	}
+
+	return n.offsets[i]
+}
+
+// restoreError maps the offset and text of a *ParseError back to input
+func (n normalized) restoreError(err error) error {
+	var e *ParseError
+	if n.offsets == nil || !errors.As(err, &e) {
+		return err
+	}
+
+	restored := *e
+	restored.Input = n.input
+
+	if e.Offset >= 0 && e.Offset+len(e.Value) <= len(n.value) {
+		restored.Offset = n.offset(e.Offset)
+		restored.Value = n.input[restored.Offset:n.offset(e.Offset+len(e.Value))]
+	}
+
+	return &restored
+}
+
+// restore maps the result and error of parsing value back to input
+func (n normalized) restore(r ParseResult, err error) (ParseResult, error) {
+	if n.offsets == nil {
+		return r, err
+	}
+
+	r.Start = n.offset(r.Start)
+	r.End = n.offset(r.End)
+
+	for i, w := range r.Warnings {
+		r.Warnings[i] = n.restoreError(w)
+	}
+
+	return r, n.restoreError(err)
+}
+
+// normalize folds value unless normalization is disabled
+func (ctx parseContext) normalize(value string) normalized {
+	if !ctx.normalization {
+		return normalized{input: value, value: value}
+	}
+
+	return normalize(value)
+}
+
+// run parses the normalized value and maps offsets back to value
+func (ctx parseContext) run(parse func(string, parseContext) (ParseResult, error), value string) (ParseResult, error) {
+	n := ctx.normalize(value)
+	return n.restore(parse(n.value, ctx))
+}
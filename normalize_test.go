@@ -0,0 +1,105 @@
+package parsetime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFoldRune(test *testing.T) {
+	assert := assert.New(test)
+
+	tests := map[rune]rune{
+		'２':      '2',
+		'－':      '-',
+		'：':      ':',
+		'Ｔ':      'T',
+		'\u00a0': ' ',
+		'\u2009': ' ',
+		'\u3000': ' ',
+		'\u2010': '-',
+		'\u2013': '-',
+		'\u2212': '-',
+		'\u2236': ':',
+		'年':      '年',
+		'a':      'a',
+	}
+
+	for r, expected := range tests {
+		assert.Equal(expected, foldRune(r), string(r))
+	}
+}
+
+func TestNormalize(test *testing.T) {
+	assert := assert.New(test)
+
+	n := normalize("２００６－０１")
+	assert.Equal("2006-01", n.value, "Incorrect value")
+	assert.Equal(0, n.offset(0), "Incorrect offset")
+	assert.Equal(3, n.offset(1), "Incorrect offset")
+	assert.Equal(len("２００６－０１"), n.offset(len(n.value)), "Incorrect end offset")
+
+	n = normalize("2006-01-02")
+	assert.Nil(n.offsets, "unchanged input has no offsets")
+}
+
+func TestParseNormalized(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := New(WithFixedZone("UTC", 0))
+	expected := time.Date(2006, time.January, 2, 15, 4, 5, 0, time.FixedZone("", -7*3600))
+
+	for _, in := range []string{
+		"２００６－０１－０２ １５：０４：０５ －０７：００",
+		"2006-01-02\u00a015:04:05 \u221207:00",
+		"2006\u201001\u201002T15\u223604\u223605\u221207:00",
+	} {
+		r, err := p.ParseDetailed(in)
+		assert.Equal(nil, err, in)
+		assert.True(expected.Equal(r.Time), "%s: %s", in, r.Time)
+		assert.Equal(FormatISO8601, r.Format, in)
+		assert.Equal(0, r.Start, in)
+		assert.Equal(len(in), r.End, in)
+		assert.Equal(0, r.Priority, in)
+
+		t, err := p.ISO8601(in)
+		assert.Equal(nil, err, in)
+		assert.True(expected.Equal(t), in)
+	}
+}
+
+func TestNormalizedErrorOffsets(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := New(WithFixedZone("UTC", 0), WithStrict())
+
+	in := "２００６－０１－０２ １５：０４：０５ ＋１５：００"
+	_, err := p.Parse(in)
+
+	var perr *ParseError
+	if assert.True(errors.As(err, &perr), "Incorrect error") {
+		assert.Equal(in, perr.Input, "Incorrect input")
+		assert.Equal("offset", perr.Field, "Incorrect field")
+		assert.Equal("＋１５：００", perr.Value, "Incorrect value")
+		assert.Equal(len("２００６－０１－０２ １５：０４：０５ "), perr.Offset, "Incorrect offset")
+	}
+}
+
+func TestWithoutNormalization(test *testing.T) {
+	assert := assert.New(test)
+
+	p, err := New(WithFixedZone("UTC", 0), WithStrict(), WithoutNormalization())
+	assert.Equal(nil, err, "Invalid options")
+	assert.False(p.GetNormalization(), "Incorrect normalization")
+
+	_, err = p.Parse("2006\u221201\u221202")
+	assert.NotNil(err, "Unicode minus is not folded")
+
+	p.SetNormalization(true)
+	assert.True(p.GetNormalization(), "Incorrect normalization")
+
+	_, err = p.Parse("2006\u221201\u221202")
+	assert.Equal(nil, err, "Unicode minus is folded")
+}
@@ -0,0 +1,128 @@
+package parsetime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var utcOffsets = []struct {
+	value  string
+	offset int
+}{
+	{"+10:00", 10 * 3600},
+	{"+12:45", 12*3600 + 45*60},
+	{"+14:00", 14 * 3600},
+	{"+00:00", 0},
+	{"-00:00", 0},
+	{"+0530", 5*3600 + 30*60},
+	{"+0545", 5*3600 + 45*60},
+	{"+0900", 9 * 3600},
+	{"+09", 9 * 3600},
+	{"-12:00", -12 * 3600},
+	{"+05:30:15", 5*3600 + 30*60 + 15},
+	{"UTC+9", 9 * 3600},
+	{"GMT-03:30", -(3*3600 + 30*60)},
+}
+
+func TestUTCOffsetLocation(test *testing.T) {
+	assert := assert.New(test)
+
+	for _, o := range utcOffsets {
+		loc, err := utcOffsetLocation(o.value)
+		assert.Equal(nil, err, o.value)
+		assert.Equal(o.offset, getOffset(time.Date(2006, time.January, 2, 0, 0, 0, 0, loc)), o.value)
+	}
+
+	for _, v := range []string{"+15:00", "-13:00", "+14:30"} {
+		_, err := utcOffsetLocation(v)
+		assert.True(errors.Is(err, ErrOutOfRange), v)
+	}
+}
+
+func TestUTCOffsetFormats(test *testing.T) {
+	assert := assert.New(test)
+	p, _ := New(WithLocation(time.UTC))
+
+	for _, o := range utcOffsets {
+		values := map[string]string{
+			"ISO8601":    "2006-01-02T15:04:05" + o.value,
+			"RFC8xx1123": "Mon, 02 Jan 2006 15:04:05 " + o.value,
+			"ANSIC":      "Mon Jan 02 15:04:05 " + o.value + " 2006",
+			"US":         "Jan 2, 2006 at 3:04:05pm " + o.value,
+		}
+
+		for format, value := range values {
+			var t time.Time
+			var err error
+
+			switch format {
+			case "ISO8601":
+				t, err = p.ISO8601(value)
+			case "RFC8xx1123":
+				t, err = p.RFC8xx1123(value)
+			case "ANSIC":
+				t, err = p.ANSIC(value)
+			case "US":
+				t, err = p.US(value)
+			}
+
+			assert.Equal(nil, err, value)
+			assert.Equal(o.offset, getOffset(t), value)
+			assert.Equal(15, t.Hour(), value)
+		}
+	}
+}
+
+func TestUTCOffsetOutOfRange(test *testing.T) {
+	assert := assert.New(test)
+	p, _ := New(WithLocation(time.UTC))
+
+	_, err := p.ISO8601("2006-01-02T15:04:05+15:00")
+
+	var perr *ParseError
+	assert.True(errors.As(err, &perr), "Error must be a *ParseError")
+	assert.True(errors.Is(err, ErrOutOfRange), "Error must wrap ErrOutOfRange")
+	assert.Equal("offset", perr.Field, "Incorrect field")
+	assert.Equal(19, perr.Offset, "Incorrect offset")
+}
+
+func TestUTCOffsetOutOfRangeParse(test *testing.T) {
+	assert := assert.New(test)
+	p, _ := New(WithLocation(time.UTC))
+
+	errs := []struct {
+		value  string
+		offset int
+	}{
+		{"2006-01-02T15:04:05+15:00", 19},
+		{"Mon, 02 Jan 2006 15:04:05 +15:00", 26},
+	}
+
+	for _, e := range errs {
+		_, err := p.Parse(e.value)
+
+		var perr *ParseError
+		assert.True(errors.As(err, &perr), e.value)
+		assert.True(errors.Is(err, ErrOutOfRange), e.value)
+		assert.Equal("offset", perr.Field, e.value)
+		assert.Equal(e.offset, perr.Offset, e.value)
+	}
+}
+
+func TestBareUTCOffset(test *testing.T) {
+	assert := assert.New(test)
+	p, _ := New(WithLocation(time.UTC))
+
+	// 01-02-06 is a date, not 01:00 at -02:00
+	r, err := p.ParseDetailed("01-02-06")
+	assert.Equal(nil, err)
+	assert.Equal(time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC), r.Time)
+	assert.False(r.ExplicitOffset)
+
+	r, err = p.ParseDetailed("2006-01-02T15:04:05-07")
+	assert.Equal(nil, err)
+	assert.Equal(-7*3600, getOffset(r.Time))
+}
@@ -0,0 +1,151 @@
+package parsetime
+
+import (
+	"time"
+)
+
+// Option configures a ParseTime
+type Option func(*ParseTime) error
+
+// New returns a new parser configured by the options.
+// Without WithLocation, WithLocationName or WithFixedZone the parser uses
+// the current local zone as a fixed offset, like NewParseTime().
+func New(opts ...Option) (ParseTime, error) {
+	pt := ParseTime{
+		location: localZone(),
+	}
+
+	for _, opt := range opts {
+		if err := opt(&pt); err != nil {
+			return ParseTime{}, err
+		}
+	}
+
+	return pt, nil
+}
+
+// WithLocation sets the location used when the input has no offset
+func WithLocation(loc *time.Location) Option {
+	return func(pt *ParseTime) error {
+		if loc == nil {
+			return ErrInvalidArgs
+		}
+
+		pt.location = loc
+		return nil
+	}
+}
+
+// WithLocationName sets the location by IANA location name (US/Arizona)
+// or timezone abbreviation (MST).
+// An empty name selects the current local zone.
+func WithLocationName(name string) Option {
+	return func(pt *ParseTime) error {
+		loc, err := loadLocation(name)
+		if err != nil {
+			return err
+		}
+
+		pt.location = loc
+		return nil
+	}
+}
+
+// WithFixedZone sets a fixed zone with the given name and offset in seconds east of UTC
+func WithFixedZone(name string, offset int) Option {
+	return func(pt *ParseTime) error {
+		pt.location = time.FixedZone(name, offset)
+		return nil
+	}
+}
+
+// WithClock sets the Clock used for fields missing from the input
+func WithClock(clock Clock) Option {
+	return func(pt *ParseTime) error {
+		if clock == nil {
+			return ErrInvalidArgs
+		}
+
+		pt.clock = clock
+		return nil
+	}
+}
+
+// WithStrict enables strict mode, see ParseTime.SetStrict
+func WithStrict() Option {
+	return func(pt *ParseTime) error {
+		pt.strict = true
+		return nil
+	}
+}
+
+// WithWeekdayCheck checks weekday names against the date, see ParseTime.SetWeekdayCheck
+func WithWeekdayCheck() Option {
+	return func(pt *ParseTime) error {
+		pt.checkWeekday = true
+		return nil
+	}
+}
+
+// WithoutNormalization disables folding full-width and Unicode punctuation
+// before matching, see ParseTime.SetNormalization
+func WithoutNormalization() Option {
+	return func(pt *ParseTime) error {
+		pt.noNormalization = true
+		return nil
+	}
+}
+
+// WithDateOrder sets the order of day, month and year in numeric dates
+func WithDateOrder(order DateOrder) Option {
+	return func(pt *ParseTime) error {
+		if order < DateOrderAuto || order > DateOrderYMD {
+			return ErrInvalidArgs
+		}
+
+		pt.dateOrder = order
+		return nil
+	}
+}
+
+// WithTwoDigitYearPivot places two-digit years in the 100 years from start,
+// see ParseTime.SetTwoDigitYearPivot
+func WithTwoDigitYearPivot(start int) Option {
+	return func(pt *ParseTime) error {
+		if start < 1 || start > 9900 {
+			return ErrInvalidArgs
+		}
+
+		pt.SetTwoDigitYearPivot(start)
+		return nil
+	}
+}
+
+// WithSlidingTwoDigitYearWindow places two-digit years in the 100 years ending
+// future years after the clock's current year,
+// see ParseTime.SetSlidingTwoDigitYearWindow
+func WithSlidingTwoDigitYearWindow(future int) Option {
+	return func(pt *ParseTime) error {
+		if future < 0 || future > 99 {
+			return ErrInvalidArgs
+		}
+
+		pt.SetSlidingTwoDigitYearWindow(future)
+		return nil
+	}
+}
+
+// WithLocales recognizes the words of the locales in addition to English,
+// see ParseTime.SetLocales
+func WithLocales(locales ...*Locale) Option {
+	return func(pt *ParseTime) error {
+		for _, l := range locales {
+			if l == nil {
+				return ErrInvalidArgs
+			}
+		}
+
+		pt.SetLocales(locales...)
+		return nil
+	}
+}
@@ -0,0 +1,70 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(test *testing.T) {
+	assert := assert.New(test)
+
+	p, err := New()
+	assert.Equal(nil, err, "Invalid options")
+	zone, offset := time.Now().In(time.Local).Zone()
+	assert.Equal(time.FixedZone(zone, offset).String(), p.GetLocation().String(), "Incorrect location")
+	assert.Equal(SystemClock, p.GetClock(), "Incorrect clock")
+}
+
+func TestNewWithOptions(test *testing.T) {
+	assert := assert.New(test)
+
+	loc, _ := time.LoadLocation("US/Arizona")
+	p, err := New(WithLocation(loc))
+	assert.Equal(nil, err, "Invalid options")
+	assert.Equal(loc.String(), p.GetLocation().String(), "Incorrect location")
+
+	p, err = New(WithLocationName("Etc/GMT+12"))
+	assert.Equal(nil, err, "Invalid options")
+	assert.Equal("Etc/GMT+12", p.GetLocation().String(), "Incorrect location")
+
+	p, err = New(WithFixedZone("MST", -7*3600))
+	assert.Equal(nil, err, "Invalid options")
+	t, _ := p.Parse("2006-01-02T15:04:05")
+	assert.Equal(-7*3600, getOffset(t), "Incorrect offset")
+
+	ref := time.Date(2016, time.May, 6, 0, 0, 0, 0, time.UTC)
+	p, err = New(WithClock(FixedClock(ref)))
+	assert.Equal(nil, err, "Invalid options")
+	assert.Equal(ref, p.GetClock().Now(), "Incorrect clock")
+}
+
+func TestNewInvalidOptions(test *testing.T) {
+	assert := assert.New(test)
+
+	_, err := New(WithLocation(nil))
+	assert.NotNil(err, "nil location must fail")
+
+	_, err = New(WithClock(nil))
+	assert.NotNil(err, "nil clock must fail")
+
+	_, err = New(WithLocationName("Invalid/Location"))
+	assert.NotNil(err, "unknown location must fail")
+}
+
+func TestNewParseTimeInvalidTypes(test *testing.T) {
+	assert := assert.New(test)
+
+	_, err := NewParseTime(1)
+	assert.NotNil(err, "int location must fail")
+
+	_, err = NewParseTime("MST", "-0700")
+	assert.NotNil(err, "string offset must fail")
+
+	_, err = NewParseTime(-7*3600, "MST")
+	assert.NotNil(err, "swapped arguments must fail")
+
+	_, err = NewParseTime("MST", -7*3600, 0)
+	assert.NotNil(err, "too many arguments must fail")
+}
@@ -8,78 +8,121 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode"
 	"unicode/utf8"
 
 	"github.com/tkuchiki/go-timezone"
 )
 
 var (
-	errInvalidDateTime = errors.New("Invalid date/time")
-	errInvalidOffset   = errors.New("Invalid offset")
-	errInvalidArgs     = errors.New("Invalid arguments")
-	errInvalidTimezone = errors.New("Invalid timezone")
-	reISO8601          = regexp.MustCompile(ISO8601)
-	reRFC8xx1123       = regexp.MustCompile(RFC8xx1123)
-	reANSIC            = regexp.MustCompile(ANSIC)
-	reUS               = regexp.MustCompile(US)
+	reISO8601       = regexp.MustCompile(ISO8601)
+	reUnix          = regexp.MustCompile(Unix)
+	reUTCOffset     = regexp.MustCompile(`^(?:UTC|GMT)?([+-])([0-9]{1,2})(?::?([0-5][0-9]))?(?::?([0-5][0-9]))?$`)
+	reStrictISO8601 = anchor(ISO8601)
+	defaultPatterns = compilePatterns(englishWords)
+
+	// ISO8601 without the bare year, for the 15:04 reading of 1504
+	iso8601Clock         = strings.Replace(ISO8601, isoBareYear, `([^\s\S])`, 1)
+	reISO8601Clock       = regexp.MustCompile(iso8601Clock)
+	reStrictISO8601Clock = anchor(iso8601Clock)
 )
 
-type sortedTime struct {
-	time     time.Time
-	priority int
-}
-
-type sortedTimes []sortedTime
-
-func (st sortedTimes) Len() int           { return len(st) }
-func (st sortedTimes) Swap(i, j int)      { st[i], st[j] = st[j], st[i] }
-func (st sortedTimes) Less(i, j int) bool { return st[i].priority < st[j].priority }
+// epochMinDigits is the shortest digit string Parse treats as a Unix epoch.
+// Nine digits covers every timestamp from 1973-03-03 onward and keeps
+// hhmmss and YYYYMMDD inputs with the ISO8601 parser; a four-digit number
+// is read there as a year with hhmm as the next reading.
+const epochMinDigits = 9
 
 // ParseTime parses the date/time string
 type ParseTime struct {
-	location *time.Location
+	location     *time.Location
+	clock        Clock
+	strict       bool
+	dateOrder    DateOrder
+	twoDigitYear yearWindow
+	checkWeekday bool
+	locales      []*Locale
+	patterns     *patternSet
+	// noNormalization disables folding full-width and Unicode punctuation
+	noNormalization bool
 }
 
-// NewParseTime returns a new parser
-func NewParseTime(location ...interface{}) (ParseTime, error) {
-	var loc *time.Location
-	var err error
+// parseContext holds the settings shared by the parsers during one parse
+type parseContext struct {
+	loc       *time.Location
+	now       time.Time
+	strict    bool
+	dateOrder DateOrder
+	// yearStart is the first year of the window two-digit years are placed in
+	yearStart    int
+	checkWeekday bool
+	locales      []*Locale
+	patterns     *patternSet
+	// normalization folds full-width and Unicode punctuation before matching
+	normalization bool
+}
 
+// NewParseTime returns a new parser.
+// It accepts no argument (local zone), a *time.Location, a location or
+// timezone name, or a zone name and offset in seconds.
+// New with options is preferred for anything else.
+func NewParseTime(location ...interface{}) (ParseTime, error) {
 	switch len(location) {
 	case 0:
-		zone, offset := time.Now().In(time.Local).Zone()
-		loc = time.FixedZone(zone, offset)
+		return New()
 	case 1:
 		switch val := location[0].(type) {
 		case *time.Location:
-			loc = val
+			return New(WithLocation(val))
 		case string:
-			if val == "" {
-				zone, offset := time.Now().In(time.Local).Zone()
-				loc = time.FixedZone(zone, offset)
-			} else {
-				loc, err = time.LoadLocation(val)
-				if err != nil {
-					tz := timezone.New()
-					tzAbbrInfo, err := tz.GetTzAbbreviationInfo(val)
-					if err != nil && !(isRFC2822Abbrs(val)) {
-						return ParseTime{}, err
-					}
-					loc = time.FixedZone(val, tzAbbrInfo[0].Offset())
-				}
-			}
+			return New(WithLocationName(val))
 		default:
 			return ParseTime{}, fmt.Errorf("Invalid type: %T", val)
 		}
 	case 2:
-		loc = time.FixedZone(location[0].(string), location[1].(int))
-	default:
-		return ParseTime{}, errInvalidArgs
+		name, ok := location[0].(string)
+		if !ok {
+			return ParseTime{}, fmt.Errorf("Invalid type: %T", location[0])
+		}
+
+		offset, ok := location[1].(int)
+		if !ok {
+			return ParseTime{}, fmt.Errorf("Invalid type: %T", location[1])
+		}
+
+		return New(WithFixedZone(name, offset))
 	}
 
-	return ParseTime{
-		location: loc,
-	}, err
+	return ParseTime{}, ErrInvalidArgs
+}
+
+func localZone() *time.Location {
+	zone, offset := time.Now().In(time.Local).Zone()
+	return time.FixedZone(zone, offset)
+}
+
+// loadLocation loads a location name or timezone abbreviation
+func loadLocation(name string) (*time.Location, error) {
+	if name == "" {
+		return localZone(), nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err == nil {
+		return loc, nil
+	}
+
+	tz := timezone.New()
+	tzAbbrInfo, err := tz.GetTzAbbreviationInfo(name)
+	if err != nil && !(isRFC2822Abbrs(name)) {
+		return nil, err
+	}
+
+	if len(tzAbbrInfo) == 0 {
+		return nil, ErrInvalidTimezone
+	}
+
+	return time.FixedZone(name, tzAbbrInfo[0].Offset()), nil
 }
 
 // GetLocation returns *time.Location
@@ -92,38 +135,182 @@ func (pt *ParseTime) SetLocation(loc *time.Location) {
 	pt.location = loc
 }
 
-func fixedZone(t time.Time) *time.Location {
-	zone, offset := t.Zone()
-	return time.FixedZone(zone, offset)
+// GetClock returns the Clock used for fields missing from the input
+func (pt *ParseTime) GetClock() Clock {
+	if pt.clock == nil {
+		return SystemClock
+	}
+
+	return pt.clock
 }
 
-func parseOffset(value string) (*time.Location, error) {
-	var err error
-	var t time.Time
-	var loc *time.Location
+// SetClock sets the Clock used for fields missing from the input
+func (pt *ParseTime) SetClock(clock Clock) {
+	pt.clock = clock
+}
 
-	t, err = time.Parse("-07:00", value)
-	if err == nil {
-		return fixedZone(t), nil
+// GetStrict reports whether strict mode is enabled
+func (pt *ParseTime) GetStrict() bool {
+	return pt.strict
+}
+
+// SetStrict enables or disables strict mode.
+// In strict mode the whole input, ignoring surrounding spaces, must be
+// consumed by one format, the year, month and day must be present and
+// out-of-range values and weekdays that do not match the date are errors.
+func (pt *ParseTime) SetStrict(strict bool) {
+	pt.strict = strict
+}
+
+// GetWeekdayCheck reports whether weekday names are checked against the date
+func (pt *ParseTime) GetWeekdayCheck() bool {
+	return pt.checkWeekday
+}
+
+// SetWeekdayCheck enables or disables checking a weekday name against the
+// date, e.g. "Tue, 02 Jan 2006" fails with ErrWeekdayMismatch because
+// January 2, 2006 was a Monday.
+// Strict mode always checks; otherwise a mismatch is only reported in
+// ParseResult.Warnings.
+func (pt *ParseTime) SetWeekdayCheck(check bool) {
+	pt.checkWeekday = check
+}
+
+// GetNormalization reports whether input is normalized before matching
+func (pt *ParseTime) GetNormalization() bool {
+	return !pt.noNormalization
+}
+
+// SetNormalization enables or disables folding full-width characters
+// (２００６－０１－０２), Unicode dashes and minus signs, non-ASCII spaces and
+// colon look-alikes to ASCII before matching. It is enabled by default.
+// Offsets in results and errors always refer to the original input.
+func (pt *ParseTime) SetNormalization(normalize bool) {
+	pt.noNormalization = !normalize
+}
+
+// GetDateOrder returns the order of day, month and year in numeric dates
+func (pt *ParseTime) GetDateOrder() DateOrder {
+	return pt.dateOrder
+}
+
+// SetDateOrder sets the order of day, month and year in numeric dates
+func (pt *ParseTime) SetDateOrder(order DateOrder) {
+	pt.dateOrder = order
+}
+
+// GetTwoDigitYearStart returns the first year of the 100-year window
+// two-digit years are placed in, relative to the clock for a sliding window
+func (pt *ParseTime) GetTwoDigitYearStart() int {
+	return pt.twoDigitYear.start(pt.GetClock().Now())
+}
+
+// SetTwoDigitYearPivot places two-digit years in the 100 years from start,
+// e.g. with 1930, 45 is 1945 and 29 is 2029.
+// The default is 1970; 0 restores it.
+func (pt *ParseTime) SetTwoDigitYearPivot(start int) {
+	pt.twoDigitYear = yearWindow{pivot: start}
+}
+
+// SetSlidingTwoDigitYearWindow places two-digit years in the 100 years
+// ending future years after the clock's current year,
+// e.g. with 0 in 2024, 24 is 2024 and 25 is 1925
+func (pt *ParseTime) SetSlidingTwoDigitYearWindow(future int) {
+	pt.twoDigitYear = yearWindow{sliding: true, future: future}
+}
+
+// GetLocales returns the locales recognized in addition to English
+func (pt *ParseTime) GetLocales() []*Locale {
+	return pt.locales
+}
+
+// SetLocales sets the locales recognized in addition to English.
+// No locale restores English only.
+func (pt *ParseTime) SetLocales(locales ...*Locale) {
+	pt.locales = locales
+	pt.patterns = nil
+
+	if len(locales) > 0 {
+		pt.patterns = compilePatterns(localeWords(locales))
 	}
+}
 
-	t, err = time.Parse("-0700", value)
-	if err == nil {
-		return fixedZone(t), nil
+// context captures the reference time once for a parse
+func (pt *ParseTime) context() parseContext {
+	now := pt.GetClock().Now()
+
+	patterns := pt.patterns
+	if patterns == nil {
+		patterns = defaultPatterns
 	}
 
-	_, err = time.Parse("MST", value)
-	if err == nil {
-		tz := timezone.New()
-		tzAbbrInfo, err := tz.GetTzAbbreviationInfo(value)
-		if err != nil && !(isRFC2822Abbrs(value)) {
-			return loc, err
+	return parseContext{
+		loc:          pt.location,
+		now:          now,
+		strict:       pt.strict,
+		dateOrder:    pt.dateOrder,
+		yearStart:    pt.twoDigitYear.start(now),
+		checkWeekday: pt.checkWeekday,
+		locales:      pt.locales,
+		patterns:     patterns,
+
+		normalization: !pt.noNormalization,
+	}
+}
+
+// UTC offsets in use range from -12:00 (Baker Island) to +14:00 (Kiribati)
+const (
+	minUTCOffset = -12 * 60 * 60
+	maxUTCOffset = 14 * 60 * 60
+)
+
+// utcOffsetLocation converts ±hh, ±hhmm, ±hh:mm, ±hh:mm:ss, UTC+9 or GMT-03:30
+// to a fixed zone.
+// -00:00 is accepted as UTC.
+func utcOffsetLocation(value string) (*time.Location, error) {
+	group := reUTCOffset.FindStringSubmatch(value)
+	if group == nil {
+		return nil, ErrInvalidOffset
+	}
+
+	offset := 0
+	for i, unit := range []int{60 * 60, 60, 1} {
+		if group[i+2] == "" {
+			continue
 		}
 
-		return time.FixedZone(value, tzAbbrInfo[0].Offset()), nil
+		n, _ := strconv.Atoi(group[i+2])
+		offset += n * unit
+	}
+
+	if group[1] == "-" {
+		offset = -offset
+	}
+
+	if offset < minUTCOffset || offset > maxUTCOffset {
+		return nil, ErrOutOfRange
+	}
+
+	return time.FixedZone("", offset), nil
+}
+
+func parseOffset(value string) (*time.Location, error) {
+	if reUTCOffset.MatchString(value) {
+		return utcOffsetLocation(value)
+	}
+
+	_, err := time.Parse("MST", value)
+	if err != nil {
+		return nil, ErrInvalidOffset
+	}
+
+	tz := timezone.New()
+	tzAbbrInfo, err := tz.GetTzAbbreviationInfo(value)
+	if (err != nil && !(isRFC2822Abbrs(value))) || len(tzAbbrInfo) == 0 {
+		return nil, ErrInvalidTimezone
 	}
 
-	return loc, errInvalidOffset
+	return time.FixedZone(value, tzAbbrInfo[0].Offset()), nil
 }
 
 func toLocation(offset string) (*time.Location, error) {
@@ -139,64 +326,90 @@ func toLocation(offset string) (*time.Location, error) {
 	return loc, err
 }
 
-func twoDigitTo4DigitYear(year string) (int, error) {
-	val, err := strconv.Atoi(year)
-	if err != nil {
-		return 0, err
+// dropZone leaves an offset word that is not a zone, such as the "world"
+// of hello 12 world, out of the match
+func dropZone(r *ParseResult, f *dateFields, value string) {
+	zone := f.offset
+	if zone.value == "" || strings.ContainsAny(zone.value[:1], "+-") {
+		return
+	}
+
+	if _, err := toLocation(zone.value); err == nil {
+		return
 	}
 
-	if val >= 70 && val <= 99 {
-		return 1900 + val, err
+	f.offset = capture{pos: -1}
+	endBefore(r, value, zone.pos)
+}
+
+// dropBareOffset leaves a bare ±hh that directly follows a number other
+// than a minute or second out of the match, so 01-02-06 is not
+// 01:00 -02:00 and 2006-13 is not 2006 at -13:00
+func dropBareOffset(r *ParseResult, f *dateFields, value string) {
+	offset := f.offset
+	if len(offset.value) != len("+hh") || !strings.ContainsAny(offset.value[:1], "+-") ||
+		(f.min.value != "" && f.min.pos >= 0) || offset.pos == 0 || !isDigit(value[offset.pos-1]) {
+		return
 	}
 
-	return 2000 + val, err
+	f.offset = capture{pos: -1}
+	endBefore(r, value, offset.pos)
 }
 
-func dateToInt(date string, dateType string, loc *time.Location) (int, error) {
+// endBefore ends the match of r before pos and the spaces preceding it
+func endBefore(r *ParseResult, value string, pos int) {
+	r.End = len(strings.TrimRightFunc(value[:pos], func(c rune) bool { return unicode.IsSpace(c) || c == '(' }))
+	if r.End < r.Start {
+		r.End = r.Start
+	}
+
+	r.Priority = stringLen(value) - stringLen(value[r.Start:r.End])
+}
+
+func dateToInt(date string, dateType string, now time.Time, yearStart int) (int, error) {
 	var err error
 	var val int
 
 	if date == "" {
 		switch dateType {
 		case "year":
-			val = time.Now().In(loc).Year()
+			val = now.Year()
 		case "month":
-			val = int(time.Now().In(loc).Month())
+			val = int(now.Month())
 		case "day":
-			val = time.Now().In(loc).Day()
+			val = now.Day()
 		case "hour":
-			val = time.Now().In(loc).Hour()
+			val = now.Hour()
 		case "min":
-			val = time.Now().In(loc).Minute()
+			val = now.Minute()
 		case "sec":
-			if date == "" {
-				val = 0
-			} else {
-				val = time.Now().In(loc).Second()
-			}
-		case "nsec":
-			if date == "" {
-				val = 0
-			} else {
-				val = time.Now().In(loc).Nanosecond()
-			}
+			val = 0
 		default:
-			err = errInvalidDateTime
+			err = ErrInvalidDateTime
 		}
 	} else {
 		switch dateType {
 		case "year":
 			if stringLen(date) == 2 {
-				return twoDigitTo4DigitYear(date)
+				val, err = strconv.Atoi(date)
+				if err != nil {
+					return val, ErrOutOfRange
+				}
+
+				return twoDigitTo4DigitYear(val, yearStart), nil
 			}
 		case "month":
-			if _, ok := Months[date]; ok {
-				return Months[date], nil
+			if val, ok := monthNumber(date); ok {
+				return val, nil
 			}
 		}
 
 		val, err = strconv.Atoi(date)
-		return val, err
+		if err != nil {
+			return val, ErrOutOfRange
+		}
+
+		return val, nil
 	}
 
 	return val, err
@@ -210,333 +423,597 @@ func stringLen(value string) int {
 	return utf8.RuneCountInString(strings.Join(strings.Fields(value), ""))
 }
 
-func to24Hour(ampm string, value int) int {
-	if strings.ToUpper(ampm) == "PM" {
-		return 12 + value
+// to24Hour converts an hour of the 12-hour clock (1-12) to the 24-hour clock.
+// 12 AM is midnight and 12 PM is noon.
+func to24Hour(ampm string, value int) (int, error) {
+	if value < 1 || value > 12 {
+		return value, ErrOutOfRange
 	}
 
-	return value
-}
+	if value == 12 {
+		value = 0
+	}
 
-func parseISO8601(value string, loc *time.Location) (time.Time, int, error) {
-	var t time.Time
-	var priority int
-	var err error
+	if strings.HasPrefix(strings.ToUpper(ampm), "P") {
+		value += 12
+	}
 
-	group := reISO8601.FindStringSubmatch(value)
+	return value, nil
+}
 
-	if len(group) == 0 {
-		return t, priority, errInvalidDateTime
-	}
+func parseISO8601(value string, ctx parseContext) (ParseResult, error) {
+	return parseISO(value, ctx, reISO8601, reStrictISO8601)
+}
 
-	priority = stringLen(value) - stringLen(group[0])
+// parseISO8601Clock reads a number that ISO8601 takes as a year, e.g. 1504,
+// as hhmm
+func parseISO8601Clock(value string, ctx parseContext) (ParseResult, error) {
+	return parseISO(value, ctx, reISO8601Clock, reStrictISO8601Clock)
+}
 
-	var year, month, day, hour, min, sec, nsec int
+func parseISO(value string, ctx parseContext, re, strictRe *regexp.Regexp) (ParseResult, error) {
+	index := dateMatch(re, value, ctx.match(re, strictRe, value), 20, false)
 
-	if group[8] != "" {
-		loc, err = toLocation(group[8])
-		if err != nil {
-			return t, priority, err
-		}
+	if index == nil {
+		return ParseResult{}, noMatchError(value, FormatISO8601)
 	}
 
-	year, err = dateToInt(group[1], "year", loc)
-	if err != nil {
-		return t, priority, err
-	}
+	r := newResult(FormatISO8601, value, index)
+	group := submatches(value, index)
 
-	month, err = dateToInt(group[2], "month", loc)
-	if err != nil {
-		return t, priority, err
+	f := dateFields{
+		year:   group[1],
+		month:  group[2],
+		day:    group[3],
+		hour:   group[15],
+		min:    group[16],
+		sec:    group[17],
+		nsec:   group[18],
+		offset: group[19],
 	}
 
-	day, err = dateToInt(group[3], "day", loc)
-	if err != nil {
-		return t, priority, err
+	// 2006-01-02 15:04:05 PST
+	if zone := group[20]; f.offset.value == "" {
+		f.offset = zone
 	}
 
-	// 2006-01-02 -> 2006-01-02T00:00
-	if isOnlyDate(group[1], group[2], group[3], group[4], group[5]) {
-		group[4] = "0"
-		group[5] = "0"
+	dropZone(&r, &f, value)
+	dropBareOffset(&r, &f, value)
+
+	if err := f.checkSplit(value, FormatISO8601, true); err != nil {
+		return r, err
 	}
 
-	hour, err = dateToInt(group[4], "hour", loc)
-	if err != nil {
-		return t, priority, err
+	// reduced precision is the start of the period, not the current date
+	precision := Precision(-1)
+	first := func(at capture) capture {
+		return capture{value: "1", pos: at.pos}
 	}
 
-	min, err = dateToInt(group[5], "min", loc)
-	if err != nil {
-		return t, priority, err
+	switch {
+	// 20060102
+	case group[4].value != "":
+		f.year, f.month, f.day = group[4], group[5], group[6]
+	// 2006-W01-1
+	case group[7].value != "":
+		year, _ := strconv.Atoi(group[7].value)
+		week, _ := strconv.Atoi(group[8].value)
+		weekday := 1
+		if group[9].value != "" {
+			weekday, _ = strconv.Atoi(group[9].value)
+		} else {
+			precision = PrecisionWeek
+		}
+
+		if week < 1 || week > weeksIn(year) {
+			if ctx.strict {
+				return r, newParseError(value, FormatISO8601, group[8], "week", ErrOutOfRange)
+			}
+
+			r.Rollover = true
+		}
+
+		f.year, f.month, f.day = isoDate(group[7], weekDate(year, week, weekday))
+	// 2006-002
+	case group[10].value != "":
+		year, _ := strconv.Atoi(group[10].value)
+		day, _ := strconv.Atoi(group[11].value)
+
+		if day < 1 || day > daysInYear(year) {
+			if ctx.strict {
+				return r, newParseError(value, FormatISO8601, group[11], "day", ErrOutOfRange)
+			}
+
+			r.Rollover = true
+		}
+
+		f.year, f.month, f.day = isoDate(group[10], ordinalDate(year, day))
+	// 2006-01
+	case group[12].value != "":
+		f.year, f.month, f.day = group[12], group[13], first(group[13])
+		precision = PrecisionMonth
+	// 2006
+	case group[14].value != "":
+		f.year, f.month, f.day = group[14], first(group[14]), first(group[14])
+		precision = PrecisionYear
+	}
+
+	// 2006-01-02T15, 2006-01-02T15,5
+	if f.hour.value != "" && f.min.value == "" {
+		f.min = capture{value: "0", pos: -1}
+		if f.nsec.value == "" {
+			precision = PrecisionHour
+		}
 	}
 
-	sec, err = dateToInt(group[6], "sec", loc)
-	if err != nil {
-		return t, priority, err
+	if err := f.resolve(&r, value, ctx); err != nil {
+		return r, err
 	}
 
-	nsec, err = dateToInt(group[7], "nsec", loc)
-	if err != nil {
-		return t, priority, err
+	// 2006-W01 is the whole week, 2006-01 the whole month
+	if precision >= 0 && (f.hour.value == "" || precision == PrecisionHour) {
+		r.Precision = precision
 	}
 
-	return time.Date(year, time.Month(month), day, hour, min, sec, nsec, loc), priority, err
+	return r, nil
+}
+
+// isoDate returns the captures of a calendar date converted from a week or
+// ordinal date, positioned at the year
+func isoDate(at capture, date time.Time) (capture, capture, capture) {
+	year, month, day := date.Date()
+
+	return capture{value: strconv.Itoa(year), pos: at.pos},
+		capture{value: strconv.Itoa(int(month)), pos: at.pos},
+		capture{value: strconv.Itoa(day), pos: at.pos}
 }
 
 // ISO8601 parses ISO8601, RFC3339 date/time string
 func (pt *ParseTime) ISO8601(value string) (time.Time, error) {
-	t, _, err := parseISO8601(value, pt.location)
-	return t, err
+	r, err := pt.context().run(parseISO8601, value)
+	return r.Time, err
 }
 
 // RFC822, RFC850, RFC1123
-func parseRFC8xx1123(value string, loc *time.Location) (time.Time, int, error) {
-	var t time.Time
-	var priority int
-	var err error
+func parseRFC8xx1123(value string, ctx parseContext) (ParseResult, error) {
+	index := ctx.match(ctx.patterns.rfc8xx1123, ctx.patterns.strictRFC8xx1123, value)
 
-	group := reRFC8xx1123.FindStringSubmatch(value)
-
-	if len(group) == 0 {
-		return t, priority, errInvalidDateTime
+	if index == nil {
+		return ParseResult{}, noMatchError(value, FormatRFC8xx1123)
 	}
 
-	priority = stringLen(value) - stringLen(group[0])
-
-	var year, month, day, hour, min, sec, nsec int
+	r := newResult(FormatRFC8xx1123, value, index)
+	group := submatches(value, index)
 
-	if group[8] != "" {
-		loc, err = toLocation(group[8])
-		if err != nil {
-			return t, priority, err
-		}
+	f := dateFields{
+		weekday: group[1],
+		day:     group[2],
+		month:   group[3],
+		year:    group[4],
+		hour:    group[5],
+		min:     group[6],
+		sec:     group[7],
+		nsec:    group[8],
+		offset:  group[9],
 	}
 
-	day, err = dateToInt(group[1], "day", loc)
-	if err != nil {
-		return t, priority, err
+	if !ctx.acceptsDateOrder(DateOrderDMY, f.month.value) {
+		return r, noMatchError(value, FormatRFC8xx1123)
 	}
 
-	month, err = dateToInt(group[2], "month", loc)
-	if err != nil {
-		return t, priority, err
-	}
+	dropBareOffset(&r, &f, value)
 
-	year, err = dateToInt(group[3], "year", loc)
-	if err != nil {
-		return t, priority, err
+	if err := f.checkSplit(value, FormatRFC8xx1123, false); err != nil {
+		return r, err
 	}
 
-	// 02-Jan-06 -> 02-Jan-06 00:00
-	if isOnlyDate(group[1], group[2], group[3], group[4], group[5]) {
-		group[4] = "0"
-		group[5] = "0"
+	if ctx.dateOrder == DateOrderAuto && f.isDateOrderAmbiguous() {
+		r.Ambiguity |= AmbiguousDateOrder
 	}
 
-	hour, err = dateToInt(group[4], "hour", loc)
-	if err != nil {
-		return t, priority, err
+	return r, f.resolve(&r, value, ctx)
+}
+
+// RFC8xx1123 parses RFC822, RFC850, RFC1123 date/time string
+func (pt *ParseTime) RFC8xx1123(value string) (time.Time, error) {
+	r, err := pt.context().run(parseRFC8xx1123, value)
+	return r.Time, err
+}
+
+func parseANSIC(value string, ctx parseContext) (ParseResult, error) {
+	index := ctx.match(ctx.patterns.ansic, ctx.patterns.strictANSIC, value)
+
+	if index == nil {
+		return ParseResult{}, noMatchError(value, FormatANSIC)
 	}
 
-	min, err = dateToInt(group[5], "min", loc)
-	if err != nil {
-		return t, priority, err
+	r := newResult(FormatANSIC, value, index)
+	group := submatches(value, index)
+
+	f := dateFields{
+		weekday: group[1],
+		month:   group[2],
+		day:     group[3],
+		hour:    group[4],
+		min:     group[5],
+		sec:     group[6],
+		nsec:    group[7],
+		offset:  group[8],
+		year:    group[9],
 	}
 
-	sec, err = dateToInt(group[6], "sec", loc)
-	if err != nil {
-		return t, priority, err
+	if !ctx.acceptsDateOrder(DateOrderMDY, f.month.value) {
+		return r, noMatchError(value, FormatANSIC)
 	}
 
-	nsec, err = dateToInt(group[7], "nsec", loc)
-	if err != nil {
-		return t, priority, err
+	if err := f.checkSplit(value, FormatANSIC, false); err != nil {
+		return r, err
 	}
 
-	return time.Date(year, time.Month(month), day, hour, min, sec, nsec, loc), priority, err
+	return r, f.resolve(&r, value, ctx)
 }
 
-// RFC8xx1123 parses RFC822, RFC850, RFC1123 date/time string
-func (pt *ParseTime) RFC8xx1123(value string) (time.Time, error) {
-	t, _, err := parseRFC8xx1123(value, pt.location)
-	return t, err
+// ANSIC parses ANSIC date/time string
+func (pt *ParseTime) ANSIC(value string) (time.Time, error) {
+	r, err := pt.context().run(parseANSIC, value)
+	return r.Time, err
 }
 
-func parseANSIC(value string, loc *time.Location) (time.Time, int, error) {
-	var t time.Time
-	var err error
-	var priority int
+// parseDate parses a numeric or named date in the given order followed by
+// a time of day on the 12-hour or 24-hour clock
+func parseDate(value string, ctx parseContext, format string, order DateOrder, re, strictRe *regexp.Regexp) (ParseResult, error) {
+	if r, err := ctx.checkNumericDate(value, format, order); err != nil {
+		return r, err
+	}
 
-	group := reANSIC.FindStringSubmatch(value)
+	index := dateMatch(re, value, ctx.match(re, strictRe, value), 13, true)
 
-	if len(group) == 0 {
-		return t, priority, errInvalidDateTime
+	if index == nil {
+		return ParseResult{}, noMatchError(value, format)
 	}
 
-	priority = stringLen(value) - stringLen(group[0])
+	r := newResult(format, value, index)
+	group := submatches(value, index)
 
-	var year, month, day, hour, min, sec, nsec int
-
-	if group[7] != "" {
-		loc, err = toLocation(group[7])
-		if err != nil {
-			return t, priority, err
-		}
+	f := dateFields{
+		weekday: group[1],
+		hour:    group[8],
+		min:     group[9],
+		sec:     group[10],
+		nsec:    group[11],
+		ampm:    group[12],
+		offset:  group[13],
 	}
 
-	month, err = dateToInt(group[1], "month", loc)
-	if err != nil {
-		return t, priority, err
+	switch order {
+	case DateOrderDMY:
+		f.day, f.month, f.year = group[2], group[3], group[4]
+	case DateOrderYMD:
+		f.year, f.month, f.day = group[2], group[3], group[4]
+	default:
+		f.month, f.day, f.year = group[2], group[3], group[4]
 	}
 
-	day, err = dateToInt(group[2], "day", loc)
-	if err != nil {
-		return t, priority, err
+	if !ctx.acceptsDateOrder(order, f.month.value) {
+		return r, noMatchError(value, format)
 	}
 
-	hour, err = dateToInt(group[3], "hour", loc)
-	if err != nil {
-		return t, priority, err
+	r.dateOrder = numericDateOrder(order, f.month.value)
+
+	// 3pm, noon, midnight
+	hourOnly := true
+	switch word := strings.ToLower(group[7].value); {
+	case group[5].value != "":
+		f.hour, f.ampm = group[5], group[6]
+		f.min = capture{value: "0", pos: -1}
+	case word == "noon":
+		f.hour = capture{value: "12", pos: group[7].pos}
+		f.min = capture{value: "0", pos: -1}
+	case word == "midnight":
+		f.hour = capture{value: "0", pos: group[7].pos}
+		f.min = capture{value: "0", pos: -1}
+	default:
+		hourOnly = false
 	}
 
-	min, err = dateToInt(group[4], "min", loc)
-	if err != nil {
-		return t, priority, err
+	dropZone(&r, &f, value)
+	dropBareOffset(&r, &f, value)
+
+	if err := f.checkSplit(value, format, false); err != nil {
+		return r, err
 	}
 
-	sec, err = dateToInt(group[5], "sec", loc)
-	if err != nil {
-		return t, priority, err
+	if ctx.dateOrder == DateOrderAuto && f.isDateOrderAmbiguous() {
+		r.Ambiguity |= AmbiguousDateOrder
 	}
 
-	nsec, err = dateToInt(group[6], "nsec", loc)
-	if err != nil {
-		return t, priority, err
+	if err := f.resolve(&r, value, ctx); err != nil {
+		return r, err
 	}
 
-	year, err = dateToInt(group[8], "year", loc)
-	if err != nil {
-		return t, priority, err
+	if hourOnly {
+		r.Precision = PrecisionHour
 	}
 
-	return time.Date(year, time.Month(month), day, hour, min, sec, nsec, loc), priority, err
+	return r, nil
 }
 
-// ANSIC parses ANSIC date/time string
-func (pt *ParseTime) ANSIC(value string) (time.Time, error) {
-	t, _, err := parseANSIC(value, pt.location)
-	return t, err
+func parseUS(value string, ctx parseContext) (ParseResult, error) {
+	return parseDate(value, ctx, FormatUS, DateOrderMDY, ctx.patterns.us, ctx.patterns.strictUS)
 }
 
-func parseUS(value string, loc *time.Location) (time.Time, int, error) {
-	var t time.Time
-	var priority int
-	var err error
+// US parses MM/DD/YYYY format date/time string
+func (pt *ParseTime) US(value string) (time.Time, error) {
+	r, err := pt.context().run(parseUS, value)
+	return r.Time, err
+}
 
-	group := reUS.FindStringSubmatch(value)
+func parseDMY(value string, ctx parseContext) (ParseResult, error) {
+	return parseDate(value, ctx, FormatDMY, DateOrderDMY, ctx.patterns.dmy, ctx.patterns.strictDMY)
+}
 
-	if len(group) == 0 {
-		return t, priority, errInvalidDateTime
-	}
+// DMY parses DD/MM/YYYY format date/time string
+func (pt *ParseTime) DMY(value string) (time.Time, error) {
+	r, err := pt.context().run(parseDMY, value)
+	return r.Time, err
+}
 
-	priority = stringLen(value) - stringLen(group[0])
+func parseYMD(value string, ctx parseContext) (ParseResult, error) {
+	return parseDate(value, ctx, FormatYMD, DateOrderYMD, ctx.patterns.ymd, ctx.patterns.strictYMD)
+}
 
-	var year, month, day, hour, min, sec, nsec int
+// YMD parses YY/MM/DD format date/time string
+func (pt *ParseTime) YMD(value string) (time.Time, error) {
+	r, err := pt.context().run(parseYMD, value)
+	return r.Time, err
+}
 
-	if group[9] != "" {
-		loc, err = toLocation(group[9])
-		if err != nil {
-			return t, priority, err
-		}
-	}
+// epochScale returns the number of nanoseconds in one unit of an epoch
+// with the given count of integer digits
+func epochScale(digits int) (int64, error) {
+	switch {
+	case digits <= 11:
+		return int64(time.Second), nil
+	case digits <= 14:
+		return int64(time.Millisecond), nil
+	case digits <= 17:
+		return int64(time.Microsecond), nil
+	case digits <= 19:
+		return int64(time.Nanosecond), nil
+	}
+
+	return 0, ErrOutOfRange
+}
 
-	month, err = dateToInt(group[1], "month", loc)
-	if err != nil {
-		return t, priority, err
+func parseUnix(value string, ctx parseContext) (ParseResult, error) {
+	index := reUnix.FindStringSubmatchIndex(value)
+
+	if index == nil {
+		return ParseResult{}, noMatchError(value, FormatUnix)
 	}
 
-	day, err = dateToInt(group[2], "day", loc)
+	r := newResult(FormatUnix, value, index)
+	r.ExplicitOffset = true
+	group := submatches(value, index)
+
+	scale, err := epochScale(len(group[2].value))
 	if err != nil {
-		return t, priority, err
+		return r, newParseError(value, FormatUnix, group[2], "epoch", err)
 	}
 
-	year, err = dateToInt(group[3], "year", loc)
+	n, err := strconv.ParseInt(group[2].value, 10, 64)
 	if err != nil {
-		return t, priority, err
+		return r, newParseError(value, FormatUnix, group[2], "epoch", ErrOutOfRange)
 	}
 
-	if isOnlyDate(group[1], group[2], group[3], group[4], group[5]) {
-		group[4] = "0"
-		group[5] = "0"
+	var frac int64
+	if group[3].value != "" {
+		frac, _ = strconv.ParseInt((group[3].value + "000000000")[:9], 10, 64)
 	}
 
-	hour, err = dateToInt(group[4], "hour", loc)
-	if err != nil {
-		return t, priority, err
+	r.Precision = PrecisionSecond
+	if scale != int64(time.Second) || group[3].value != "" {
+		r.Precision = PrecisionNanosecond
 	}
 
-	min, err = dateToInt(group[5], "min", loc)
-	if err != nil {
-		return t, priority, err
-	}
+	perSec := int64(time.Second) / scale
+	sec := n / perSec
+	nsec := (n%perSec)*scale + frac*scale/int64(time.Second)
 
-	sec, err = dateToInt(group[6], "sec", loc)
-	if err != nil {
-		return t, priority, err
+	if group[1].value == "-" {
+		sec, nsec = -sec, -nsec
 	}
 
-	nsec, err = dateToInt(group[7], "nsec", loc)
-	if err != nil {
-		return t, priority, err
+	r.Time = time.Unix(sec, nsec).In(ctx.loc)
+
+	return r, nil
+}
+
+// isCompactDate reports whether the digits form a valid YYYYMMDD,
+// YYYYMMDDhhmm or YYYYMMDDhhmmss date/time
+func isCompactDate(digits string) bool {
+	for _, layout := range []string{"20060102", "200601021504", "20060102150405"} {
+		if len(layout) != len(digits) {
+			continue
+		}
+
+		if _, err := time.Parse(layout, digits); err == nil {
+			return true
+		}
 	}
 
-	ampm := group[8]
+	return false
+}
+
+// isEpoch reports whether Parse should read value as a Unix epoch.
+// A digit string is an epoch when it has at least epochMinDigits integer
+// digits and, without a fractional part, is not a valid compact ISO8601
+// date/time such as 20060102150405.
+func isEpoch(value string) bool {
+	group := reUnix.FindStringSubmatch(value)
 
-	if ampm != "" {
-		hour = to24Hour(ampm, hour)
+	if len(group) == 0 || len(group[2]) < epochMinDigits {
+		return false
 	}
 
-	return time.Date(year, time.Month(month), day, hour, min, sec, nsec, loc), priority, err
+	return group[3] != "" || !isCompactDate(group[2])
 }
 
-// US parses MM/DD/YYYY format date/time string
-func (pt *ParseTime) US(value string) (time.Time, error) {
-	t, _, err := parseUS(value, pt.location)
-	return t, err
+// Unix parses Unix epoch seconds, milliseconds, microseconds or nanoseconds.
+// The unit is detected from the number of integer digits:
+// up to 11 digits are seconds, 12-14 milliseconds, 15-17 microseconds
+// and 18-19 nanoseconds. A fractional part is a fraction of that unit.
+func (pt *ParseTime) Unix(value string) (time.Time, error) {
+	r, err := pt.context().run(parseUnix, value)
+	return r.Time, err
 }
 
-// Parse parses date/time string
-func (pt *ParseTime) Parse(value string) (time.Time, error) {
-	times := make(sortedTimes, 0)
-	t, priority, _ := parseISO8601(value, pt.location)
-	if !t.IsZero() {
-		times = append(times, sortedTime{time: t, priority: priority})
+// ParseAll parses date/time string with every format and returns all
+// successful interpretations, best first.
+// Results are ranked by Priority (fewest unmatched characters first).
+// On a tie the result with the fewest defaulted fields comes first, then
+// Unix and ISO8601, then a numeric date read in the preferred date order
+// (month first with DateOrderAuto), then the fixed format order
+// Unix, ISO8601, RFC8xx1123, ANSIC, US, DMY, CJK, Relative, YMD.
+// Results that tie on Priority but disagree on the time are flagged
+// with AmbiguousFormat, unless the other reading defaults more fields or
+// rolls over. Zero-width matches are dropped when anything else matched.
+// If a format that read more of the input than the best result failed,
+// e.g. on an offset out of range, its error is returned instead.
+func (pt *ParseTime) ParseAll(value string) ([]ParseResult, error) {
+	return parseAll(value, pt.context())
+}
+
+// parseAll is ParseAll with the reference time and options of ctx
+func parseAll(value string, ctx parseContext) ([]ParseResult, error) {
+	results := make([]ParseResult, 0)
+	formats := make([]string, 0)
+	perr := noMatchError(value)
+	priority := 0
+
+	n := ctx.normalize(value)
+
+	add := func(format string, r ParseResult, err error) {
+		formats = append(formats, format)
+		r, err = n.restore(r, err)
+
+		if err == nil {
+			results = append(results, r)
+			return
+		}
+
+		// keep the error of the format that consumed the most input, on a
+		// tie the one of the format for the configured date order
+		var e *ParseError
+		if errors.As(err, &e) && e.Field != "" && (perr.Field == "" || r.Priority < priority ||
+			(r.Priority == priority && format == dateOrderFormats[ctx.dateOrder])) {
+			perr = e
+			priority = r.Priority
+		}
+	}
+
+	if isEpoch(n.value) {
+		r, err := parseUnix(n.value, ctx)
+		add(FormatUnix, r, err)
+	}
+
+	for _, p := range ctx.parsers() {
+		r, err := p.parse(n.value, ctx)
+
+		// 1504 is the year 1504 and then 15:04
+		if p.format == FormatISO8601 && err == nil && r.Precision == PrecisionYear && isDigits(n.value[r.Start:r.End]) {
+			if clock, err := parseISO8601Clock(n.value, ctx); err == nil {
+				r.Ambiguity |= AmbiguousFormat
+				clock.Ambiguity |= AmbiguousFormat
+				clock, _ = n.restore(clock, nil)
+				results = append(results, clock)
+			}
+		}
+
+		add(p.format, r, err)
 	}
 
-	t, priority, _ = parseRFC8xx1123(value, pt.location)
-	if !t.IsZero() {
-		times = append(times, sortedTime{time: t, priority: priority})
+	if len(results) == 0 {
+		e := *perr
+		e.Formats = formats
+		return nil, &e
 	}
 
-	t, priority, _ = parseANSIC(value, pt.location)
-	if !t.IsZero() {
-		times = append(times, sortedTime{time: t, priority: priority})
+	// a zero-width match, e.g. the US clock in front of yesterday, is not a
+	// reading of the input unless nothing else matched
+	read := make([]ParseResult, 0, len(results))
+	for _, r := range results {
+		if r.Start != r.End {
+			read = append(read, r)
+		}
 	}
 
-	t, priority, _ = parseUS(value, pt.location)
-	if !t.IsZero() {
-		times = append(times, sortedTime{time: t, priority: priority})
+	if len(read) > 0 {
+		results = read
 	}
 
-	if len(times) == 0 {
-		var tmpT time.Time
-		return tmpT, errInvalidDateTime
+	preferred := ctx.preferredDateOrder()
+	rank := func(r ParseResult) int {
+		switch {
+		case r.Format == FormatUnix || r.Format == FormatISO8601:
+			return 0
+		case r.dateOrder == preferred:
+			return 1
+		}
+
+		return 2
 	}
 
-	sort.Sort(times)
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Priority != results[j].Priority {
+			return results[i].Priority < results[j].Priority
+		}
+
+		// Jan 2 2006 is a US date before an ANSIC time with a defaulted year
+		if a, b := results[i].Defaulted.count(), results[j].Defaulted.count(); a != b {
+			return a < b
+		}
+
+		return rank(results[i]) < rank(results[j])
+	})
 
-	return times[0].time, nil
+	// an error such as a wrong weekday or an offset out of range is not
+	// hidden by a shorter match of another format
+	if perr.Field != "" && priority < results[0].Priority {
+		e := *perr
+		e.Formats = formats
+		return nil, &e
+	}
+
+	// only a reading that defaults no more fields and does not roll over
+	// makes another one ambiguous
+	for i := range results {
+		for j, other := range results {
+			if i != j && results[i].Priority == other.Priority && !results[i].Time.Equal(other.Time) &&
+				other.Defaulted.count() <= results[i].Defaulted.count() && !other.Rollover {
+				results[i].Ambiguity |= AmbiguousFormat
+			}
+		}
+	}
+
+	return results, nil
+}
+
+// ParseDetailed parses date/time string and reports the matched format,
+// span, precision and offset provenance
+func (pt *ParseTime) ParseDetailed(value string) (ParseResult, error) {
+	results, err := pt.ParseAll(value)
+	if err != nil {
+		return ParseResult{}, err
+	}
+
+	return results[0], nil
+}
+
+// Parse parses date/time string
+func (pt *ParseTime) Parse(value string) (time.Time, error) {
+	r, err := pt.ParseDetailed(value)
+	return r.Time, err
 }
 
 func isRFC2822Abbrs(abbr string) bool {
@@ -1,6 +1,7 @@
 package parsetime
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -845,6 +846,33 @@ var usTimes = []TestTime{
 	},
 }
 
+var unixTimes = []TestTime{
+	{
+		Value: "1136214245",
+		Time:  time.Unix(1136214245, 0),
+	},
+	{
+		Value: "1136214245.123456",
+		Time:  time.Unix(1136214245, 123456000),
+	},
+	{
+		Value: "1136214245123",
+		Time:  time.Unix(1136214245, 123000000),
+	},
+	{
+		Value: "1136214245123456",
+		Time:  time.Unix(1136214245, 123456000),
+	},
+	{
+		Value: "1136214245123456789",
+		Time:  time.Unix(1136214245, 123456789),
+	},
+	{
+		Value: " 1136214245 ",
+		Time:  time.Unix(1136214245, 0),
+	},
+}
+
 type TestTime struct {
 	Value string
 	Time  time.Time
@@ -912,6 +940,8 @@ func testTimes(times []TestTime, parseType string, test *testing.T) {
 			t, err = p.ANSIC(tt.Value)
 		case "US":
 			t, err = p.US(tt.Value)
+		case "Unix":
+			t, err = p.Unix(tt.Value)
 		case "Parse":
 			t, err = p.Parse(tt.Value)
 		}
@@ -1019,4 +1049,279 @@ func TestParse(test *testing.T) {
 	testTimes(rfc8xx1123Times, "Parse", test)
 	testTimes(ansicTimes, "Parse", test)
 	testTimes(usTimes, "Parse", test)
+	testTimes(unixTimes, "Parse", test)
+}
+
+func TestUnix(test *testing.T) {
+	testTimes(unixTimes, "Unix", test)
+
+	assert := assert.New(test)
+	p, _ := NewParseTime()
+
+	t, err := p.Unix("-1.5")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Unix(-2, 500000000).UnixNano(), t.UnixNano(), "Parse error")
+
+	_, err = p.Unix("11362142451234567890")
+	assert.NotNil(err, "Out of range epoch")
+}
+
+func TestParseEpochOrCompactDate(test *testing.T) {
+	assert := assert.New(test)
+	p, _ := NewParseTime()
+
+	t, err := p.Parse("20060102150405")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(createTimeInLocation("20060102150405", "20060102150405", p.GetLocation()).Unix(), t.Unix(), "Parse error")
+
+	t, err = p.Parse("20060102")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(2006, t.Year(), "Parse error")
+
+	t, err = p.Parse("99999999999999")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(int64(99999999999), t.Unix(), "Parse error")
+}
+
+func TestUS12HourClock(test *testing.T) {
+	assert := assert.New(test)
+	p, _ := New(WithLocation(time.UTC))
+
+	hours := []struct {
+		value string
+		hour  int
+		min   int
+	}{
+		{"Jan 2, 2006 12:30 AM", 0, 30},
+		{"Jan 2, 2006 12:30 PM", 12, 30},
+		{"Jan 2, 2006 1:30 PM", 13, 30},
+		{"Jan 2, 2006 11:59 p.m.", 23, 59},
+		{"Jan 2, 2006 9:15 a.m.", 9, 15},
+		{"Jan 2, 2006 3pm", 15, 0},
+		{"Jan 2, 2006 at 3 PM", 15, 0},
+		{"Jan 2, 2006 12am", 0, 0},
+		{"Jan 2, 2006 noon", 12, 0},
+		{"Jan 2, 2006 Midnight", 0, 0},
+	}
+
+	for _, h := range hours {
+		r, err := p.ParseDetailed(h.value)
+		assert.Equal(nil, err, h.value)
+		assert.Equal(FormatUS, r.Format, h.value)
+		assert.Equal(time.Date(2006, time.January, 2, h.hour, h.min, 0, 0, time.UTC), r.Time, h.value)
+	}
+
+	r, err := p.ParseDetailed("Jan 2, 2006 3pm")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(PrecisionHour, r.Precision, "Incorrect precision")
+
+	for _, v := range []string{"Jan 2, 2006 13:30 PM", "Jan 2, 2006 0:30 AM", "13 PM", "Jan 2, 2006 13 PM"} {
+		_, err := p.US(v)
+		assert.True(errors.Is(err, ErrOutOfRange), v)
+
+		_, err = p.Parse(v)
+		assert.True(errors.Is(err, ErrOutOfRange), v)
+	}
+}
+
+func TestISO8601WeekAndOrdinalDates(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := New(WithLocation(time.UTC))
+	mst := time.FixedZone("", -7*3600)
+
+	tests := map[string]time.Time{
+		"2006-W01-1":                time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC),
+		"2006W011":                  time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC),
+		"2009-W01-1":                time.Date(2008, time.December, 29, 0, 0, 0, 0, time.UTC),
+		"2004-W53-7":                time.Date(2005, time.January, 2, 0, 0, 0, 0, time.UTC),
+		"2006-W01-1T15:04:05-07:00": time.Date(2006, time.January, 2, 15, 4, 5, 0, mst),
+		"2006W011T150405Z":          time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC),
+		"2006-002":                  time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC),
+		"2006002":                   time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC),
+		"2004-366":                  time.Date(2004, time.December, 31, 0, 0, 0, 0, time.UTC),
+		"2006-002T15:04:05-07:00":   time.Date(2006, time.January, 2, 15, 4, 5, 0, mst),
+		"2006002T150405Z":           time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC),
+	}
+
+	for in, expected := range tests {
+		t, err := p.ISO8601(in)
+		assert.Equal(nil, err, in)
+		assert.True(expected.Equal(t), "%s: %s", in, t)
+
+		r, err := p.ParseDetailed(in)
+		assert.Equal(nil, err, in)
+		assert.Equal(FormatISO8601, r.Format, in)
+		assert.True(expected.Equal(r.Time), "%s: %s", in, r.Time)
+	}
+
+	r, err := p.ParseDetailed("2006-W01")
+	assert.Equal(nil, err, "week without weekday")
+	assert.Equal(PrecisionWeek, r.Precision, "Incorrect precision")
+	assert.Equal(time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC), r.Time, "week starts on Monday")
+
+	r, err = p.ParseDetailed("2006-W53-1")
+	assert.Equal(nil, err, "lenient mode rolls over")
+	assert.True(r.Rollover, "Rollover must be reported")
+
+	r, err = p.ParseDetailed("2006-366")
+	assert.Equal(nil, err, "lenient mode rolls over")
+	assert.True(r.Rollover, "Rollover must be reported")
+
+	p.SetStrict(true)
+
+	_, err = p.Parse("2006-W53-1")
+	assert.True(errors.Is(err, ErrOutOfRange), "2006 has 52 weeks")
+
+	_, err = p.Parse("2006-366")
+	assert.True(errors.Is(err, ErrOutOfRange), "2006 has 365 days")
+
+	_, err = p.Parse("2004-W53-7")
+	assert.Equal(nil, err, "2004 has 53 weeks")
+}
+
+func TestISO8601ReducedPrecision(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := New(WithLocation(time.UTC), WithClock(FixedClock(time.Date(2016, time.May, 6, 7, 8, 9, 0, time.UTC))))
+
+	tests := []struct {
+		value     string
+		expected  time.Time
+		precision Precision
+	}{
+		{"2006", time.Date(2006, time.January, 1, 0, 0, 0, 0, time.UTC), PrecisionYear},
+		{"2006-01", time.Date(2006, time.January, 1, 0, 0, 0, 0, time.UTC), PrecisionMonth},
+		{"2006-11", time.Date(2006, time.November, 1, 0, 0, 0, 0, time.UTC), PrecisionMonth},
+		{"2006-01-02", time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC), PrecisionDay},
+		{"2006-01-02T15", time.Date(2006, time.January, 2, 15, 0, 0, 0, time.UTC), PrecisionHour},
+		{"2006-01-02T15Z", time.Date(2006, time.January, 2, 15, 0, 0, 0, time.UTC), PrecisionHour},
+		{"2006-01-02T15:04", time.Date(2006, time.January, 2, 15, 4, 0, 0, time.UTC), PrecisionMinute},
+	}
+
+	for _, t := range tests {
+		r, err := p.ParseDetailed(t.value)
+		assert.Equal(nil, err, t.value)
+		assert.Equal(FormatISO8601, r.Format, t.value)
+		assert.Equal(t.expected, r.Time, t.value)
+		assert.Equal(t.precision, r.Precision, t.value)
+		assert.Equal(Field(0), r.Defaulted, t.value)
+	}
+
+	// hhmmss is still a time of day
+	r, err := p.ParseDetailed("150405")
+	assert.Equal(nil, err, "150405")
+	assert.Equal(time.Date(2016, time.May, 6, 15, 4, 5, 0, time.UTC), r.Time, "150405")
+
+	// a year alone is also hhmm, ranked after the year
+	years := []struct {
+		value     string
+		year      int
+		hour, min int
+	}{
+		{"1504", 1504, 15, 4},
+		{"0930", 930, 9, 30},
+		{"2359", 2359, 23, 59},
+	}
+
+	for _, t := range years {
+		results, err := p.ParseAll(t.value)
+		if !assert.Equal(nil, err, t.value) || !assert.True(len(results) > 1, t.value) {
+			continue
+		}
+
+		assert.Equal(time.Date(t.year, time.January, 1, 0, 0, 0, 0, time.UTC), results[0].Time, t.value)
+		assert.Equal(time.Date(2016, time.May, 6, t.hour, t.min, 0, 0, time.UTC), results[1].Time, t.value)
+		assert.Equal(FormatISO8601, results[1].Format, t.value)
+		assert.True(results[0].Ambiguity.Has(AmbiguousFormat), t.value)
+	}
+
+	// but not when a time or offset follows
+	r, err = p.ParseDetailed("1504 -07:00")
+	assert.Equal(nil, err, "1504 -07:00")
+	assert.Equal(time.Date(2016, time.May, 6, 15, 4, 0, 0, time.FixedZone("", -7*3600)).Unix(), r.Time.Unix(), "1504 -07:00")
+
+	p.SetStrict(true)
+	for _, value := range []string{"2006", "2006-01", "2006-01-02T15"} {
+		_, err := p.Parse(value)
+		assert.Equal(nil, err, "strict mode accepts reduced precision: "+value)
+	}
+}
+
+func TestISO8601ExpandedYears(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := New(WithLocation(time.UTC))
+
+	tests := map[string]time.Time{
+		"+002006-01-02":         time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC),
+		"+12006-01-02":          time.Date(12006, time.January, 2, 0, 0, 0, 0, time.UTC),
+		"-0044-03-15":           time.Date(-44, time.March, 15, 0, 0, 0, 0, time.UTC),
+		"-0044-03-15T12:00:00Z": time.Date(-44, time.March, 15, 12, 0, 0, 0, time.UTC),
+		"0044-03-15":            time.Date(44, time.March, 15, 0, 0, 0, 0, time.UTC),
+		"-0044-03":              time.Date(-44, time.March, 1, 0, 0, 0, 0, time.UTC),
+		"+10000":                time.Date(10000, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	for in, expected := range tests {
+		t, err := p.ISO8601(in)
+		assert.Equal(nil, err, in)
+		assert.Equal(expected, t, in)
+	}
+}
+
+func TestFractions(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := New(WithLocation(time.UTC))
+
+	tests := []struct {
+		value     string
+		expected  time.Time
+		precision Precision
+	}{
+		{"2006-01-02T15:04:05.9Z", time.Date(2006, time.January, 2, 15, 4, 5, 900000000, time.UTC), PrecisionNanosecond},
+		{"2006-01-02T15:04:05.09Z", time.Date(2006, time.January, 2, 15, 4, 5, 90000000, time.UTC), PrecisionNanosecond},
+		{"2006-01-02T15:04:05.123Z", time.Date(2006, time.January, 2, 15, 4, 5, 123000000, time.UTC), PrecisionNanosecond},
+		{"2006-01-02T15:04:05,123Z", time.Date(2006, time.January, 2, 15, 4, 5, 123000000, time.UTC), PrecisionNanosecond},
+		{"2006-01-02T15:04:05.123456789Z", time.Date(2006, time.January, 2, 15, 4, 5, 123456789, time.UTC), PrecisionNanosecond},
+		{"2006-01-02T15:04:05.1234567891Z", time.Date(2006, time.January, 2, 15, 4, 5, 123456789, time.UTC), PrecisionNanosecond},
+		{"2006-01-02T15:04,5Z", time.Date(2006, time.January, 2, 15, 4, 30, 0, time.UTC), PrecisionSecond},
+		{"2006-01-02T15:04,25", time.Date(2006, time.January, 2, 15, 4, 15, 0, time.UTC), PrecisionSecond},
+		{"2006-01-02T15,5Z", time.Date(2006, time.January, 2, 15, 30, 0, 0, time.UTC), PrecisionMinute},
+		{"2006-01-02T15,25", time.Date(2006, time.January, 2, 15, 15, 0, 0, time.UTC), PrecisionMinute},
+		{"20060102T1504,5", time.Date(2006, time.January, 2, 15, 4, 30, 0, time.UTC), PrecisionSecond},
+	}
+
+	for _, t := range tests {
+		r, err := p.ParseDetailed(t.value)
+		assert.Equal(nil, err, t.value)
+		assert.Equal(t.expected, r.Time, t.value)
+		assert.Equal(t.precision, r.Precision, t.value)
+	}
+
+	r, err := p.ParseDetailed("Mon, 02 Jan 2006 15:04:05,5 +0000")
+	assert.Equal(nil, err, "RFC1123 comma fraction")
+	assert.Equal(500000000, r.Time.Nanosecond(), "RFC1123 comma fraction")
+}
+
+func TestDateFieldsFraction(test *testing.T) {
+	assert := assert.New(test)
+
+	f := dateFields{
+		hour: capture{value: "15", pos: 0},
+		min:  capture{value: "04", pos: 3},
+		sec:  capture{value: "05", pos: 6},
+		nsec: capture{value: "5", pos: 9},
+	}
+	assert.Equal(int(500*time.Millisecond), f.fraction(), "fraction of a second")
+
+	f.sec = capture{}
+	assert.Equal(int(30*time.Second), f.fraction(), "fraction of a minute")
+
+	f.min = capture{value: "0", pos: -1}
+	assert.Equal(int(30*time.Minute), f.fraction(), "fraction of an hour")
+
+	f.nsec = capture{value: "999999999", pos: 3}
+	assert.Equal(int(time.Hour-3600), f.fraction(), "fraction of an hour")
 }
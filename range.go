@@ -0,0 +1,230 @@
+package parsetime
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FormatRange is the format name reported in range errors
+const FormatRange = "Range"
+
+var (
+	// last 24h, past 7 days
+	reRollingRange = regexp.MustCompile(`(?i)^\s*(?:last|past)\s+([0-9]+|an?)\s*(` + unitWords + `)\s*$`)
+	// Jan 2-5 2006
+	reDayRange = regexp.MustCompile(`(?i)^\s*((?:` + monthNames + `)[.]?)\s+([0-9]{1,2})\s*-\s*([0-9]{1,2}),?\s+([0-9]{4})\s*$`)
+	// from A to B, between A and B, A..B, A - B, A to B
+	reRangeSeps = []*regexp.Regexp{
+		regexp.MustCompile(`(?i)^\s*from\s+(.+?)\s+(?:to|until|till)\s+(.+?)\s*$`),
+		regexp.MustCompile(`(?i)^\s*between\s+(.+?)\s+and\s+(.+?)\s*$`),
+		regexp.MustCompile(`^\s*(.+?)\s*[.][.]\s*(.+?)\s*$`),
+		regexp.MustCompile(`^\s*(.+?)\s+-\s+(.+?)\s*$`),
+		regexp.MustCompile(`(?i)^\s*(.+?)\s+to\s+(.+?)\s*$`),
+	}
+)
+
+// Range is the half-open time range [Start, End)
+type Range struct {
+	Start, End time.Time
+}
+
+// Contains reports whether t is in the range
+func (r Range) Contains(t time.Time) bool {
+	return !t.Before(r.Start) && t.Before(r.End)
+}
+
+// periodEnd returns the end of the period of the given precision that
+// starts at t, e.g. the first day of the next month for PrecisionMonth
+func periodEnd(t time.Time, p Precision) time.Time {
+	for _, u := range relativeUnits {
+		if u.precision == p {
+			end, _ := u.shift(t, 1)
+			return end
+		}
+	}
+
+	return t.Add(time.Nanosecond)
+}
+
+// ParseRange parses a time range and returns it as [start, end):
+// 2006-01-02..2006-01-05, 10:00 - 12:00, from A to B, between A and B,
+// Jan 2-5 2006, last 7 days or a single date such as 2006-01 or this week.
+// Each endpoint is parsed with Parse. The end of a single date and of an
+// end point of day precision or coarser is the end of that period, so
+// 2006-01 is the whole month and 2006-01-02..2006-01-05 includes January 5.
+// An endpoint with a date but no time starts at midnight. A bare time at
+// the end takes the date of the start, and a missing year is taken from
+// the other endpoint.
+func (pt *ParseTime) ParseRange(value string) (Range, error) {
+	ctx := pt.context()
+	n := ctx.normalize(value)
+
+	r, err := parseRange(n.value, ctx)
+	return r, n.restoreError(err)
+}
+
+func parseRange(value string, ctx parseContext) (Range, error) {
+	// last 24h
+	if group := reRollingRange.FindStringSubmatchIndex(value); group != nil {
+		g := submatches(value, group)
+
+		count, err := relativeCount(g[1].value)
+		if err != nil {
+			return Range{}, newParseError(value, FormatRange, g[1], "count", err)
+		}
+
+		end := ctx.now.In(ctx.loc)
+		start, err := lookupRelativeUnit(g[2].value).shift(end, -count)
+		if err != nil {
+			return Range{}, newParseError(value, FormatRange, g[1], "count", err)
+		}
+
+		return Range{Start: start, End: end}, nil
+	}
+
+	// Jan 2-5 2006 is Jan 2, 2006..Jan 5, 2006
+	if group := reDayRange.FindStringSubmatchIndex(value); group != nil {
+		g := submatches(value, group)
+
+		var ends [2]ParseResult
+		for i, day := range []capture{g[2], g[3]} {
+			if n, _ := strconv.Atoi(day.value); n < 1 || n > 31 {
+				return Range{}, newParseError(value, FormatRange, day, "day", ErrOutOfRange)
+			}
+
+			results, err := parseAll(g[1].value+" "+day.value+", "+g[4].value, ctx)
+			if err != nil {
+				return Range{}, newParseError(value, FormatRange, day, "day", unwrapParseError(err))
+			}
+
+			ends[i] = results[0]
+		}
+
+		return rangeOf(value, ends[0], ends[1], g[3], ctx)
+	}
+
+	for i, re := range reRangeSeps {
+		group := re.FindStringSubmatchIndex(value)
+		if group == nil {
+			continue
+		}
+
+		g := submatches(value, group)
+
+		start, err := rangeEndpoint(value, g[1], ctx)
+		var end ParseResult
+		if err == nil {
+			end, err = rangeEndpoint(value, g[2], ctx)
+		}
+
+		if err == nil {
+			return rangeOf(value, start, end, g[2], ctx)
+		}
+
+		// from and between are always ranges, A - B may be a single date
+		if i < 2 {
+			return Range{}, err
+		}
+	}
+
+	trimmed := strings.TrimSpace(value)
+	single, err := rangeEndpoint(value, capture{value: trimmed, pos: strings.Index(value, trimmed)}, ctx)
+	if err != nil {
+		return Range{}, err
+	}
+
+	return Range{Start: single.Time, End: periodEnd(single.Time, single.Precision)}, nil
+}
+
+// rangeOf returns the range between the endpoints start and end, the
+// latter at b in value
+func rangeOf(value string, start, end ParseResult, b capture, ctx parseContext) (Range, error) {
+	inherited := false
+
+	switch {
+	// 2006-01-02 10:00 - 12:00
+	case end.Defaulted.Has(FieldYear|FieldMonth|FieldDay) && !start.Defaulted.Has(FieldDay):
+		// the time of day as written, not as it resolved on the current date
+		clock, err := rangeEndpoint(value, b, ctx.wall())
+		if err != nil {
+			return Range{}, err
+		}
+
+		end.Time = withDate(clock.Time, start.Time, end.ExplicitOffset)
+		inherited = true
+	// Jan 2 - Jan 5 2006
+	case start.Defaulted.Has(FieldYear) && !end.Defaulted.Has(FieldYear):
+		start.Time = withYear(start.Time, end.Time.Year())
+	// Jan 2 2006 - Jan 5
+	case end.Defaulted.Has(FieldYear) && !start.Defaulted.Has(FieldYear):
+		end.Time = withYear(end.Time, start.Time.Year())
+	}
+
+	// 22:00 - 02:00 ends the next day
+	if inherited && end.Time.Before(start.Time) {
+		end.Time = end.Time.AddDate(0, 0, 1)
+	}
+
+	r := Range{Start: start.Time, End: end.Time}
+
+	if end.Precision <= PrecisionDay {
+		r.End = periodEnd(end.Time, end.Precision)
+	}
+
+	if r.End.Before(r.Start) {
+		return Range{}, newParseError(value, FormatRange, b, "end", ErrOutOfRange)
+	}
+
+	return r, nil
+}
+
+// withDate returns the time of day of t on the date of date, in the
+// location of date unless t has an explicit offset
+func withDate(t, date time.Time, explicitOffset bool) time.Time {
+	loc := date.Location()
+	if explicitOffset {
+		loc = t.Location()
+	}
+
+	year, month, day := date.Date()
+	hour, min, sec := t.Clock()
+
+	return time.Date(year, month, day, hour, min, sec, t.Nanosecond(), loc)
+}
+
+// withYear returns t in the given year
+func withYear(t time.Time, year int) time.Time {
+	_, month, day := t.Date()
+	hour, min, sec := t.Clock()
+
+	return time.Date(year, month, day, hour, min, sec, t.Nanosecond(), t.Location())
+}
+
+// rangeEndpoint parses the endpoint part of value like Parse and maps the
+// offset of an error back to value.
+// An endpoint with a date but no time of day starts at midnight.
+func rangeEndpoint(value string, part capture, ctx parseContext) (ParseResult, error) {
+	results, err := parseAll(part.value, ctx)
+	if err == nil {
+		r := results[0]
+		if !r.Defaulted.Has(FieldDay) && r.Defaulted.Has(FieldHour) {
+			r.Time = truncate(r.Time, PrecisionDay)
+		}
+
+		return r, nil
+	}
+
+	var e *ParseError
+	if !errors.As(err, &e) || e.Field == "" {
+		return ParseResult{}, newParseError(value, FormatRange, part, "time", unwrapParseError(err))
+	}
+
+	shifted := *e
+	shifted.Input = value
+	shifted.Offset = part.pos + e.Offset
+
+	return ParseResult{}, &shifted
+}
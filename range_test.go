@@ -0,0 +1,149 @@
+package parsetime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRange(test *testing.T) {
+	assert := assert.New(test)
+
+	// Wednesday
+	ref := time.Date(2006, time.January, 4, 15, 4, 5, 0, time.UTC)
+	p, _ := New(WithLocation(time.UTC), WithClock(FixedClock(ref)))
+
+	date := func(month time.Month, day, hour, min int) time.Time {
+		return time.Date(2006, month, day, hour, min, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		value      string
+		start, end time.Time
+	}{
+		{"2006-01-02..2006-01-05", date(1, 2, 0, 0), date(1, 6, 0, 0)},
+		{"2006-01-02 .. 2006-01-05", date(1, 2, 0, 0), date(1, 6, 0, 0)},
+		{"2006-01-02 - 2006-01-05", date(1, 2, 0, 0), date(1, 6, 0, 0)},
+		{"2006-01-02 – 2006-01-05", date(1, 2, 0, 0), date(1, 6, 0, 0)},
+		{"from 2006-01-02 10:00 to 12:00", date(1, 2, 10, 0), date(1, 2, 12, 0)},
+		{"from Jan 2 2006 3pm until 5pm", date(1, 2, 15, 0), date(1, 2, 17, 0)},
+		{"between 2006-01-02 and 2006-01-03", date(1, 2, 0, 0), date(1, 4, 0, 0)},
+		{"2006-01-02 22:00 - 02:00", date(1, 2, 22, 0), date(1, 3, 2, 0)},
+		{"Jan 2-5 2006", date(1, 2, 0, 0), date(1, 6, 0, 0)},
+		{"Jan 2 - 5, 2006", date(1, 2, 0, 0), date(1, 6, 0, 0)},
+		{"2006-01", date(1, 1, 0, 0), date(2, 1, 0, 0)},
+		{"2006", date(1, 1, 0, 0), time.Date(2007, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{"2006-01-02", date(1, 2, 0, 0), date(1, 3, 0, 0)},
+		{"2006-01-02T15", date(1, 2, 15, 0), date(1, 2, 16, 0)},
+		{"2006-W01", date(1, 2, 0, 0), date(1, 9, 0, 0)},
+		{"this week", date(1, 2, 0, 0), date(1, 9, 0, 0)},
+		{"today", date(1, 4, 0, 0), date(1, 5, 0, 0)},
+		{"from yesterday to today", date(1, 3, 0, 0), date(1, 5, 0, 0)},
+		{"yesterday 5pm - 7pm", date(1, 3, 17, 0), date(1, 3, 19, 0)},
+		{"last 7 days", ref.AddDate(0, 0, -7), ref},
+		{"last 24h", ref.Add(-24 * time.Hour), ref},
+		{"past an hour", ref.Add(-time.Hour), ref},
+	}
+
+	for _, t := range tests {
+		r, err := p.ParseRange(t.value)
+		if !assert.Equal(nil, err, t.value) {
+			continue
+		}
+
+		assert.True(t.start.Equal(r.Start), "%s: start %s", t.value, r.Start)
+		assert.True(t.end.Equal(r.End), "%s: end %s", t.value, r.End)
+	}
+}
+
+func TestParseRangeYear(test *testing.T) {
+	assert := assert.New(test)
+
+	// endpoints with a date but no time start at midnight, not at 15:30
+	ref := time.Date(2016, time.May, 6, 15, 30, 0, 0, time.UTC)
+	p, _ := New(WithLocation(time.UTC), WithClock(FixedClock(ref)))
+
+	for _, v := range []string{"Jan 2 to Jan 5, 2006", "Jan 2 - Jan 5 2006", "Jan 2 2006 - Jan 5", "from Jan 2 to Jan 5 2006"} {
+		r, err := p.ParseRange(v)
+		if !assert.Equal(nil, err, v) {
+			continue
+		}
+
+		assert.Equal(time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC), r.Start, v)
+		assert.Equal(time.Date(2006, time.January, 6, 0, 0, 0, 0, time.UTC), r.End, v)
+	}
+}
+
+func TestParseRangeDST(test *testing.T) {
+	assert := assert.New(test)
+
+	// 2:30 does not exist on the current date, 2006-04-02
+	loc, _ := time.LoadLocation("America/New_York")
+	ref := time.Date(2006, time.April, 2, 12, 0, 0, 0, loc)
+	p, _ := New(WithLocation(loc), WithClock(FixedClock(ref)))
+
+	r, err := p.ParseRange("2006-01-02 22:00 - 02:30")
+	assert.Equal(nil, err)
+	assert.Equal(time.Date(2006, time.January, 3, 2, 30, 0, 0, loc), r.End)
+}
+
+func TestParseRangeReadsClockOnce(test *testing.T) {
+	assert := assert.New(test)
+
+	ref := time.Date(2006, time.January, 4, 23, 30, 0, 0, time.UTC)
+	reads := 0
+	p, _ := New(WithLocation(time.UTC), WithClock(ClockFunc(func() time.Time {
+		reads++
+		return ref.Add(time.Duration(reads-1) * time.Hour)
+	})))
+
+	r, err := p.ParseRange("from yesterday to today")
+	assert.Equal(nil, err)
+	assert.Equal(time.Date(2006, time.January, 3, 0, 0, 0, 0, time.UTC), r.Start)
+	assert.Equal(time.Date(2006, time.January, 5, 0, 0, 0, 0, time.UTC), r.End)
+	assert.Equal(1, reads, "The clock must be read once")
+}
+
+func TestRangeContains(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := New(WithLocation(time.UTC))
+	r, _ := p.ParseRange("2006-01-02")
+
+	assert.True(r.Contains(time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC)), "start is in the range")
+	assert.True(r.Contains(time.Date(2006, time.January, 2, 23, 59, 59, 999999999, time.UTC)), "Incorrect range")
+	assert.False(r.Contains(time.Date(2006, time.January, 3, 0, 0, 0, 0, time.UTC)), "end is not in the range")
+}
+
+func TestParseRangeErrors(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := New(WithLocation(time.UTC), WithStrict())
+
+	tests := []struct {
+		value  string
+		err    error
+		field  string
+		offset int
+	}{
+		{"2006-01-05..2006-01-02", ErrOutOfRange, "end", 12},
+		{"from 2006-01-02 to 2006-02-30", ErrOutOfRange, "day", 27},
+		{"Jan 2-32 2006", ErrOutOfRange, "day", 6},
+		{"Jan 0-5 2006", ErrOutOfRange, "day", 4},
+		{"last 99999999999999999999 days", ErrOutOfRange, "count", 5},
+	}
+
+	for _, t := range tests {
+		_, err := p.ParseRange(t.value)
+		assert.True(errors.Is(err, t.err), "%s: %v", t.value, err)
+
+		var perr *ParseError
+		if assert.True(errors.As(err, &perr), t.value) {
+			assert.Equal(t.value, perr.Input, t.value)
+			assert.Equal(t.field, perr.Field, t.value)
+			assert.Equal(t.offset, perr.Offset, t.value)
+		}
+	}
+}
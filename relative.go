@@ -0,0 +1,284 @@
+package parsetime
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	reRelative       = regexp.MustCompile(Relative)
+	reStrictRelative = anchor(Relative)
+)
+
+// relativeUnit is a unit of a relative offset such as 3 days ago
+type relativeUnit struct {
+	years, months, days int
+	clock               time.Duration
+	precision           Precision
+}
+
+var relativeUnits = map[string]relativeUnit{
+	"year":   {years: 1, precision: PrecisionYear},
+	"month":  {months: 1, precision: PrecisionMonth},
+	"week":   {days: 7, precision: PrecisionWeek},
+	"day":    {days: 1, precision: PrecisionDay},
+	"hour":   {clock: time.Hour, precision: PrecisionHour},
+	"minute": {clock: time.Minute, precision: PrecisionMinute},
+	"second": {clock: time.Second, precision: PrecisionSecond},
+}
+
+// relativeUnitNames maps abbreviations and plurals to relativeUnits keys
+var relativeUnitNames = map[string]string{
+	"y":   "year",
+	"mo":  "month",
+	"w":   "week",
+	"d":   "day",
+	"h":   "hour",
+	"hr":  "hour",
+	"m":   "minute",
+	"min": "minute",
+	"s":   "second",
+	"sec": "second",
+}
+
+// lookupRelativeUnit returns the unit named days, day, d, hrs, ...
+func lookupRelativeUnit(name string) relativeUnit {
+	name = strings.ToLower(name)
+	if _, ok := relativeUnits[name]; !ok && len(name) > 1 {
+		name = strings.TrimSuffix(name, "s")
+	}
+
+	if full, ok := relativeUnitNames[name]; ok {
+		name = full
+	}
+
+	return relativeUnits[name]
+}
+
+// shift returns t moved n units, the calendar units on the wall clock
+func (u relativeUnit) shift(t time.Time, n int) (time.Time, error) {
+	if u.clock != 0 {
+		if max := int(math.MaxInt64 / u.clock); n > max || n < -max {
+			return t, ErrOutOfRange
+		}
+
+		return t.Add(time.Duration(n) * u.clock), nil
+	}
+
+	return t.AddDate(n*u.years, n*u.months, n*u.days), nil
+}
+
+// truncate returns the start of the year, month, ISO week, day, hour,
+// minute or second containing t
+func truncate(t time.Time, p Precision) time.Time {
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+
+	switch p {
+	case PrecisionYear:
+		return time.Date(year, time.January, 1, 0, 0, 0, 0, t.Location())
+	case PrecisionMonth:
+		return time.Date(year, month, 1, 0, 0, 0, 0, t.Location())
+	case PrecisionWeek:
+		return time.Date(year, month, day-(int(t.Weekday())+6)%7, 0, 0, 0, 0, t.Location())
+	case PrecisionDay:
+		return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+	case PrecisionHour:
+		return time.Date(year, month, day, hour, 0, 0, 0, t.Location())
+	case PrecisionMinute:
+		return time.Date(year, month, day, hour, min, 0, 0, t.Location())
+	case PrecisionSecond:
+		return time.Date(year, month, day, hour, min, sec, 0, t.Location())
+	}
+
+	return t
+}
+
+// weekdayFrom returns the date of weekday relative to t:
+// last is the latest one before t, next the first one after t and
+// this the one in the ISO week of t
+func weekdayFrom(t time.Time, weekday time.Weekday, which string) time.Time {
+	diff := int(weekday) - int(t.Weekday())
+
+	switch which {
+	case "last":
+		if diff >= 0 {
+			diff -= 7
+		}
+	case "next":
+		if diff <= 0 {
+			diff += 7
+		}
+	default:
+		// Sunday is the last day of the ISO week
+		diff = (int(weekday)+6)%7 - (int(t.Weekday())+6)%7
+	}
+
+	return t.AddDate(0, 0, diff)
+}
+
+// relativeCount parses the 3 of 3 days ago, a and an are 1
+func relativeCount(value string) (int, error) {
+	switch strings.ToLower(value) {
+	case "a", "an":
+		return 1, nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, ErrOutOfRange
+	}
+
+	return n, nil
+}
+
+func parseRelative(value string, ctx parseContext) (ParseResult, error) {
+	index := ctx.match(reRelative, reStrictRelative, value)
+
+	if index == nil {
+		return ParseResult{}, noMatchError(value, FormatRelative)
+	}
+
+	r := newResult(FormatRelative, value, index)
+	group := submatches(value, index)
+
+	now := ctx.now.In(ctx.loc)
+	t := now
+	r.Precision = PrecisionNanosecond
+
+	// a time of day may follow a date, not an offset such as 2 hours ago
+	clock := false
+
+	switch {
+	// now
+	case group[1].value != "":
+	// today, tomorrow, yesterday
+	case group[2].value != "":
+		days := map[string]int{"today": 0, "tomorrow": 1, "yesterday": -1}[strings.ToLower(group[2].value)]
+		t = truncate(now.AddDate(0, 0, days), PrecisionDay)
+		r.Precision, clock = PrecisionDay, true
+	// last monday, next month
+	case group[3].value != "":
+		which := strings.ToLower(group[3].value)
+
+		if weekday, ok := weekdayNumber(group[4].value); ok {
+			t = truncate(weekdayFrom(now, weekday, which), PrecisionDay)
+			r.Precision, clock = PrecisionDay, true
+			break
+		}
+
+		u := relativeUnits[strings.ToLower(group[4].value)]
+		n := map[string]int{"last": -1, "next": 1, "this": 0}[which]
+		t, _ = u.shift(now, n)
+		t = truncate(t, u.precision)
+		r.Precision = u.precision
+	// 3 days ago, in 3 days
+	default:
+		count, unit, sign := group[7], group[8], 1
+		if group[5].value != "" {
+			count, unit, sign = group[5], group[6], -1
+		}
+
+		n, err := relativeCount(count.value)
+		if err != nil {
+			return r, newParseError(value, FormatRelative, count, "count", err)
+		}
+
+		u := lookupRelativeUnit(unit.value)
+		t, err = u.shift(now, sign*n)
+		if err != nil {
+			return r, newParseError(value, FormatRelative, count, "count", err)
+		}
+
+		clock = u.clock == 0
+	}
+
+	r.Time = t
+
+	if clock {
+		if err := relativeClock(&r, value, ctx); err != nil {
+			return r, err
+		}
+	}
+
+	if ctx.strict {
+		if c := unmatched(value, r); c.pos >= 0 {
+			return r, newParseError(value, FormatRelative, c, "text", ErrUnmatchedText)
+		}
+	}
+
+	return r, nil
+}
+
+// relativeClock sets the time of day that follows the date of r, e.g. the
+// 5pm of yesterday 5pm, using the US format
+func relativeClock(r *ParseResult, value string, ctx parseContext) error {
+	rest := value[r.End:]
+
+	// the date is already known, so the US format must not be strict;
+	// the clock is read on the wall clock in UTC, so that a DST change on
+	// the current date does not move the 2:30 of tomorrow 2:30am
+	lenient := ctx.wall()
+	lenient.strict = false
+
+	// only a time of day that was read, not the current one of tomorrow x
+	c, err := parseUS(rest, lenient)
+	if err != nil || c.Start == c.End || strings.TrimSpace(rest[:c.Start]) != "" ||
+		!c.Defaulted.Has(FieldYear|FieldMonth|FieldDay) || c.Defaulted.Has(FieldHour) {
+		return nil
+	}
+
+	if ctx.strict && c.Rollover {
+		return newParseError(value, FormatRelative, capture{value: rest[c.Start:c.End], pos: r.End + c.Start}, "hour", ErrOutOfRange)
+	}
+
+	loc := r.Time.Location()
+	if c.ExplicitOffset {
+		loc = c.Time.Location()
+	}
+
+	year, month, day := r.Time.Date()
+	hour, min, sec := c.Time.Clock()
+
+	// 24:00 and rolled over times are on a later day than the default
+	y, m, d := lenient.now.In(c.Time.Location()).Date()
+	cy, cm, cd := c.Time.Date()
+	day += int(time.Date(cy, cm, cd, 0, 0, 0, 0, time.UTC).Sub(time.Date(y, m, d, 0, 0, 0, 0, time.UTC)) / (24 * time.Hour))
+
+	r.Time = time.Date(year, month, day, hour, min, sec, c.Time.Nanosecond(), loc)
+	r.End += c.End
+	r.Precision = c.Precision
+	r.ExplicitOffset = c.ExplicitOffset
+	r.Priority = stringLen(value) - stringLen(value[r.Start:r.End])
+
+	return nil
+}
+
+// wall returns ctx with the wall clock of the current time in UTC as the
+// location and current time, so a time of day is read as written even if
+// it does not exist on the current date in the ParseTime location
+func (ctx parseContext) wall() parseContext {
+	now := ctx.now.In(ctx.loc)
+	year, month, day := now.Date()
+	hour, min, sec := now.Clock()
+
+	ctx.loc = time.UTC
+	ctx.now = time.Date(year, month, day, hour, min, sec, now.Nanosecond(), time.UTC)
+
+	return ctx
+}
+
+// Relative parses a date/time relative to the current time: now, today,
+// tomorrow 5pm, yesterday at noon, last monday, next friday 9am,
+// this month, 3 days ago, an hour ago, in 2 weeks.
+// Dates are resolved in the ParseTime location, so 1 day ago keeps the
+// wall clock across a DST change. Today, tomorrow, yesterday and weekdays
+// are midnight unless a time of day follows; last, next and this week,
+// month or year are the start of that period.
+func (pt *ParseTime) Relative(value string) (time.Time, error) {
+	r, err := pt.context().run(parseRelative, value)
+	return r.Time, err
+}
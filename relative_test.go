@@ -0,0 +1,163 @@
+package parsetime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRelative(test *testing.T) {
+	assert := assert.New(test)
+
+	// Wednesday
+	ref := time.Date(2006, time.January, 4, 15, 4, 5, 0, time.UTC)
+	p, _ := New(WithLocation(time.UTC), WithClock(FixedClock(ref)))
+
+	date := func(month time.Month, day, hour, min int) time.Time {
+		return time.Date(2006, month, day, hour, min, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		value     string
+		expected  time.Time
+		precision Precision
+	}{
+		{"now", ref, PrecisionNanosecond},
+		{"today", date(1, 4, 0, 0), PrecisionDay},
+		{"Yesterday", date(1, 3, 0, 0), PrecisionDay},
+		{"yesterday 5pm", date(1, 3, 17, 0), PrecisionHour},
+		{"tomorrow noon", date(1, 5, 12, 0), PrecisionHour},
+		{"tomorrow at 9:30", date(1, 5, 9, 30), PrecisionMinute},
+		{"today 24:00", date(1, 5, 0, 0), PrecisionMinute},
+		{"last monday", date(1, 2, 0, 0), PrecisionDay},
+		{"last wed", date(12, 28, 0, 0).AddDate(-1, 0, 0), PrecisionDay},
+		{"next friday", date(1, 6, 0, 0), PrecisionDay},
+		{"next wednesday 9am", date(1, 11, 9, 0), PrecisionHour},
+		{"this sunday", date(1, 8, 0, 0), PrecisionDay},
+		{"this monday", date(1, 2, 0, 0), PrecisionDay},
+		{"last week", date(1, 2, 0, 0).AddDate(0, 0, -7), PrecisionWeek},
+		{"this week", date(1, 2, 0, 0), PrecisionWeek},
+		{"next month", date(2, 1, 0, 0), PrecisionMonth},
+		{"last year", time.Date(2005, time.January, 1, 0, 0, 0, 0, time.UTC), PrecisionYear},
+		{"2 hours ago", ref.Add(-2 * time.Hour), PrecisionNanosecond},
+		{"an hour ago", ref.Add(-time.Hour), PrecisionNanosecond},
+		{"15m ago", ref.Add(-15 * time.Minute), PrecisionNanosecond},
+		{"3 days ago", ref.AddDate(0, 0, -3), PrecisionNanosecond},
+		{"in 3 weeks", ref.AddDate(0, 0, 21), PrecisionNanosecond},
+		{"in 2 days at 8am", date(1, 6, 8, 0), PrecisionHour},
+		{"in 1 month", ref.AddDate(0, 1, 0), PrecisionNanosecond},
+		{"a year ago", ref.AddDate(-1, 0, 0), PrecisionNanosecond},
+	}
+
+	for _, t := range tests {
+		r, err := p.ParseDetailed(t.value)
+		if !assert.Equal(nil, err, t.value) {
+			continue
+		}
+
+		assert.Equal(FormatRelative, r.Format, t.value)
+		assert.Equal(t.expected, r.Time, t.value)
+		assert.Equal(t.precision, r.Precision, t.value)
+		assert.Equal(0, r.Priority, t.value)
+	}
+}
+
+func TestRelativeWithoutClock(test *testing.T) {
+	assert := assert.New(test)
+
+	// Saturday
+	ref := time.Date(2024, time.March, 9, 14, 30, 0, 0, time.UTC)
+	p, _ := New(WithLocation(time.UTC), WithClock(FixedClock(ref)))
+
+	// text after the date that is not a time of day leaves it at midnight
+	tests := []struct {
+		value    string
+		expected time.Time
+	}{
+		{"tomorrow  ", time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)},
+		{"today ok", time.Date(2024, time.March, 9, 0, 0, 0, 0, time.UTC)},
+		{"next friday x", time.Date(2024, time.March, 15, 0, 0, 0, 0, time.UTC)},
+		{"yesterday 5", time.Date(2024, time.March, 8, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, t := range tests {
+		tm, err := p.Relative(t.value)
+		assert.Equal(nil, err, t.value)
+		assert.Equal(t.expected, tm, t.value)
+	}
+}
+
+func TestRelativeDST(test *testing.T) {
+	assert := assert.New(test)
+
+	loc, _ := time.LoadLocation("America/New_York")
+	// the day after the DST change on 2006-04-02
+	ref := time.Date(2006, time.April, 3, 12, 0, 0, 0, loc)
+	p, _ := New(WithLocation(loc), WithClock(FixedClock(ref.UTC())))
+
+	tests := []struct {
+		value    string
+		expected time.Time
+	}{
+		{"1 day ago", time.Date(2006, time.April, 2, 12, 0, 0, 0, loc)},
+		{"2 days ago", time.Date(2006, time.April, 1, 12, 0, 0, 0, loc)},
+		{"48 hours ago", time.Date(2006, time.April, 1, 11, 0, 0, 0, loc)},
+		{"yesterday 5pm", time.Date(2006, time.April, 2, 17, 0, 0, 0, loc)},
+		{"yesterday 5pm MST", time.Date(2006, time.April, 2, 17, 0, 0, 0, time.FixedZone("MST", -7*3600))},
+	}
+
+	for _, t := range tests {
+		tm, err := p.Relative(t.value)
+		assert.Equal(nil, err, t.value)
+		assert.True(t.expected.Equal(tm), "%s: %s", t.value, tm)
+	}
+
+	// on the day of the change 2:30am does not exist, but it does on the
+	// days before and after
+	p.SetClock(FixedClock(time.Date(2006, time.April, 2, 12, 0, 0, 0, loc)))
+
+	tests = []struct {
+		value    string
+		expected time.Time
+	}{
+		{"tomorrow 2:30am", time.Date(2006, time.April, 3, 2, 30, 0, 0, loc)},
+		{"yesterday 2:30am", time.Date(2006, time.April, 1, 2, 30, 0, 0, loc)},
+		{"yesterday at 2am", time.Date(2006, time.April, 1, 2, 0, 0, 0, loc)},
+	}
+
+	for _, t := range tests {
+		tm, err := p.Relative(t.value)
+		assert.Equal(nil, err, t.value)
+		assert.True(t.expected.Equal(tm), "%s: %s", t.value, tm)
+	}
+}
+
+func TestRelativeErrors(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := New(WithLocation(time.UTC))
+
+	_, err := p.Relative("2006-01-02")
+	assert.True(errors.Is(err, ErrInvalidDateTime), "no relative expression")
+
+	_, err = p.Relative("99999999999999999999 days ago")
+	assert.True(errors.Is(err, ErrOutOfRange), "count overflow")
+
+	_, err = p.Relative("9999999999 hours ago")
+	assert.True(errors.Is(err, ErrOutOfRange), "duration overflow")
+
+	p.SetStrict(true)
+
+	_, err = p.Relative("yesterday 5pm")
+	assert.Equal(nil, err, "time of day in strict mode")
+
+	_, err = p.Relative("yesterday foo")
+	var perr *ParseError
+	if assert.True(errors.As(err, &perr), "unmatched text in strict mode") {
+		assert.Equal(ErrUnmatchedText, perr.Err, "Incorrect error")
+		assert.Equal("foo", perr.Value, "Incorrect value")
+		assert.Equal(10, perr.Offset, "Incorrect offset")
+	}
+}
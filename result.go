@@ -0,0 +1,374 @@
+package parsetime
+
+import (
+	"errors"
+	"math/bits"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// Format names reported in ParseResult.Format
+const (
+	FormatISO8601    = "ISO8601"
+	FormatRFC8xx1123 = "RFC8xx1123"
+	FormatANSIC      = "ANSIC"
+	FormatUS         = "US"
+	FormatDMY        = "DMY"
+	FormatYMD        = "YMD"
+	FormatCJK        = "CJK"
+	FormatUnix       = "Unix"
+	FormatRelative   = "Relative"
+)
+
+// Precision is the finest date/time component present in the input
+type Precision int
+
+// Precisions, from coarsest to finest
+const (
+	PrecisionYear Precision = iota
+	PrecisionMonth
+	PrecisionWeek
+	PrecisionDay
+	PrecisionHour
+	PrecisionMinute
+	PrecisionSecond
+	PrecisionNanosecond
+)
+
+var precisionNames = []string{"year", "month", "week", "day", "hour", "minute", "second", "nanosecond"}
+
+func (p Precision) String() string {
+	if p < 0 || int(p) >= len(precisionNames) {
+		return "unknown"
+	}
+
+	return precisionNames[p]
+}
+
+// Field is a set of date/time components
+type Field uint
+
+// Date/time components
+const (
+	FieldYear Field = 1 << iota
+	FieldMonth
+	FieldDay
+	FieldHour
+	FieldMinute
+)
+
+// Has reports whether f contains all of the given fields
+func (f Field) Has(field Field) bool {
+	return f&field == field
+}
+
+// count returns the number of fields in f
+func (f Field) count() int {
+	return bits.OnesCount(uint(f))
+}
+
+// Ambiguity is a set of reasons the input has more than one reading
+type Ambiguity uint
+
+// Ambiguities
+const (
+	// AmbiguousDateOrder means the numeric day and month could be swapped,
+	// e.g. 01-02-06 is January 2 (month first) or February 1 (day first)
+	AmbiguousDateOrder Ambiguity = 1 << iota
+	// AmbiguousFormat means another format matched equally well
+	// but produced a different time
+	AmbiguousFormat
+)
+
+// Has reports whether a contains all of the given ambiguities
+func (a Ambiguity) Has(ambiguity Ambiguity) bool {
+	return a&ambiguity == ambiguity
+}
+
+// ParseResult describes how a date/time string was parsed
+type ParseResult struct {
+	// Time is the parsed date/time
+	Time time.Time
+	// Format is the name of the format that matched, e.g. FormatISO8601
+	Format string
+	// Start and End are the byte offsets of the matched substring
+	Start, End int
+	// Priority is the number of input characters outside the match.
+	// Lower is better; 0 means the whole input was consumed.
+	Priority int
+	// Precision is the finest component present in the input
+	Precision Precision
+	// Defaulted lists the components filled in from the current date/time
+	Defaulted Field
+	// ExplicitOffset reports whether the offset or zone came from the input
+	// rather than from the ParseTime location.
+	// Unix epochs are absolute instants and always report true.
+	ExplicitOffset bool
+	// Ambiguity lists the reasons the input has other readings
+	Ambiguity Ambiguity
+	// Rollover reports that an impossible date/time such as 2006-02-30 or
+	// 24:30 was normalized by time.Date, e.g. to 2006-03-02.
+	// It is only set in lenient mode; strict mode returns ErrOutOfRange.
+	Rollover bool
+	// Warnings lists problems tolerated in lenient mode, each a *ParseError,
+	// e.g. a weekday that does not match the date (ErrWeekdayMismatch)
+	Warnings []error
+
+	// dateOrder is the order a numeric date was read in by the US, DMY or
+	// YMD format, DateOrderAuto if the month was a name or absent
+	dateOrder DateOrder
+}
+
+// capture is a matched component and its byte offset in the input
+type capture struct {
+	value string
+	pos   int
+}
+
+// dateFields holds the raw components captured by a format
+type dateFields struct {
+	year, month, day, hour, min, sec, nsec, ampm, offset capture
+	weekday                                              capture
+}
+
+func (f dateFields) precision() Precision {
+	switch {
+	// a fraction of a unit is precise to the next finer unit
+	case f.nsec.value != "" && f.sec.value != "":
+		return PrecisionNanosecond
+	case f.nsec.value != "" && f.min.pos >= 0 && f.min.value != "":
+		return PrecisionSecond
+	case f.nsec.value != "":
+		return PrecisionMinute
+	case f.sec.value != "":
+		return PrecisionSecond
+	case f.min.value != "":
+		return PrecisionMinute
+	case f.hour.value != "":
+		return PrecisionHour
+	case f.day.value != "":
+		return PrecisionDay
+	case f.month.value != "":
+		return PrecisionMonth
+	}
+
+	return PrecisionYear
+}
+
+// fraction returns the decimal fraction of the finest component in
+// nanoseconds: 15:04:05.9 is 900ms, 15:04,5 is 30s and 15,5 is 30m.
+// Digits finer than a nanosecond are truncated.
+func (f dateFields) fraction() int {
+	if f.nsec.value == "" {
+		return 0
+	}
+
+	unit := time.Hour
+	switch {
+	case f.sec.value != "":
+		unit = time.Second
+	case f.min.pos >= 0 && f.min.value != "":
+		unit = time.Minute
+	}
+
+	return int(scaleFraction(f.nsec.value, unit))
+}
+
+// scaleFraction returns the decimal fraction digits of unit,
+// truncated to nanoseconds
+func scaleFraction(digits string, unit time.Duration) time.Duration {
+	if len(digits) > 9 {
+		digits = digits[:9]
+	}
+
+	n, _ := strconv.ParseInt(digits, 10, 64)
+	scale := int64(1)
+	for range digits {
+		scale *= 10
+	}
+
+	// n < scale, so this cannot overflow
+	return time.Duration(n * (int64(unit) / scale))
+}
+
+// isDateOrderAmbiguous reports whether a numeric day and month are both
+// valid months and differ
+func (f dateFields) isDateOrderAmbiguous() bool {
+	month, err := strconv.Atoi(f.month.value)
+	if err != nil {
+		return false
+	}
+
+	day, err := strconv.Atoi(f.day.value)
+	if err != nil {
+		return false
+	}
+
+	return day >= 1 && day <= 12 && day != month
+}
+
+func newResult(format, value string, index []int) ParseResult {
+	return ParseResult{
+		Format:   format,
+		Start:    index[0],
+		End:      index[1],
+		Priority: stringLen(value) - stringLen(value[index[0]:index[1]]),
+	}
+}
+
+// hasCapture reports whether any of the groups matched text
+func hasCapture(group []capture) bool {
+	for _, c := range group {
+		if c.value != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// dateMatch returns index, or the next match of re that captured a date or
+// time before the zone group if index did not: a zone abbreviation alone,
+// e.g. the "hello" of "hello 12", is not a date/time, and neither is an
+// empty match unless empty is set
+func dateMatch(re *regexp.Regexp, value string, index []int, zone int, empty bool) []int {
+	if index == nil {
+		return nil
+	}
+
+	group := submatches(value, index)
+	if hasCapture(group[1:zone]) || (empty && group[zone].value == "") {
+		return index
+	}
+
+	for _, next := range re.FindAllStringSubmatchIndex(value, -1) {
+		if hasCapture(submatches(value, next)[1:zone]) {
+			return next
+		}
+	}
+
+	return nil
+}
+
+// submatches returns the captures for a FindStringSubmatchIndex result
+func submatches(value string, index []int) []capture {
+	group := make([]capture, len(index)/2)
+
+	for i := range group {
+		group[i].pos = index[2*i]
+		if index[2*i] >= 0 {
+			group[i].value = value[index[2*i]:index[2*i+1]]
+		}
+	}
+
+	return group
+}
+
+// resolve converts the fields to a time and fills in the result
+func (f dateFields) resolve(r *ParseResult, input string, ctx parseContext) error {
+	var err error
+
+	loc := ctx.loc
+
+	r.Precision = f.precision()
+
+	if f.offset.value != "" {
+		loc, err = toLocation(f.offset.value)
+		if errors.Is(err, ErrInvalidTimezone) {
+			return newParseError(input, r.Format, f.offset, "zone", err)
+		} else if err != nil {
+			return newParseError(input, r.Format, f.offset, "offset", err)
+		}
+
+		r.ExplicitOffset = true
+	}
+
+	// 2006-01-02 -> 2006-01-02T00:00
+	if isOnlyDate(f.year.value, f.month.value, f.day.value, f.hour.value, f.min.value) {
+		f.hour.value = "0"
+		f.min.value = "0"
+	}
+
+	now := ctx.now.In(loc)
+
+	// janvier -> 1
+	if n, ok := ctx.monthNumber(f.month.value); ok {
+		f.month.value = strconv.Itoa(n)
+	}
+
+	components := []struct {
+		capture
+		dateType string
+		name     string
+		field    Field
+	}{
+		{f.year, "year", "year", FieldYear},
+		{f.month, "month", "month", FieldMonth},
+		{f.day, "day", "day", FieldDay},
+		{f.hour, "hour", "hour", FieldHour},
+		{f.min, "min", "minute", FieldMinute},
+		{f.sec, "sec", "second", 0},
+	}
+
+	values := make([]int, len(components))
+
+	for i, c := range components {
+		if c.value == "" {
+			r.Defaulted |= c.field
+		}
+
+		values[i], err = dateToInt(c.value, c.dateType, now, ctx.yearStart)
+		if err != nil {
+			return newParseError(input, r.Format, c.capture, c.name, err)
+		}
+
+		if ctx.strict && c.value != "" {
+			if err = checkRange(c.name, values[i]); err != nil {
+				return newParseError(input, r.Format, c.capture, c.name, err)
+			}
+		}
+	}
+
+	year, month, day, hour, min, sec := values[0], values[1], values[2], values[3], values[4], values[5]
+	nsec := f.fraction()
+
+	// impossible dates are errors in strict mode and roll over otherwise,
+	// e.g. 2006-02-30 -> 2006-03-02
+	if name := calendarError(year, month, day, hour, min, sec, nsec); name != "" {
+		if ctx.strict {
+			for _, c := range components {
+				if c.name == name {
+					return newParseError(input, r.Format, c.capture, c.name, ErrOutOfRange)
+				}
+			}
+		}
+
+		r.Rollover = true
+	}
+
+	if f.ampm.value != "" {
+		hour, err = to24Hour(ctx.meridiem(f.ampm.value), hour)
+		if err != nil {
+			return newParseError(input, r.Format, f.hour, "hour", err)
+		}
+	}
+
+	r.Time = time.Date(year, time.Month(month), day, hour, min, sec, nsec, loc)
+
+	// Fri, 02 Jan 2006 -> January 2, 2006 was a Monday
+	if weekday, ok := ctx.weekdayNumber(f.weekday.value); ok && weekday != r.Time.Weekday() {
+		err = newParseError(input, r.Format, f.weekday, "weekday", ErrWeekdayMismatch)
+		if ctx.strict || ctx.checkWeekday {
+			return err
+		}
+
+		r.Warnings = append(r.Warnings, err)
+	}
+
+	if ctx.strict {
+		return checkStrict(*r, input)
+	}
+
+	return nil
+}
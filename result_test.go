@@ -0,0 +1,142 @@
+package parsetime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDetailed(test *testing.T) {
+	assert := assert.New(test)
+	p, _ := NewParseTime()
+
+	r, err := p.ParseDetailed("2006-01-02T15:04:05.999-07:00")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(FormatISO8601, r.Format, "Incorrect format")
+	assert.Equal(0, r.Start, "Incorrect start")
+	assert.Equal(29, r.End, "Incorrect end")
+	assert.Equal(0, r.Priority, "Incorrect priority")
+	assert.Equal(PrecisionNanosecond, r.Precision, "Incorrect precision")
+	assert.Equal(Field(0), r.Defaulted, "Incorrect defaulted fields")
+	assert.True(r.ExplicitOffset, "Offset must be explicit")
+
+	r, err = p.ParseDetailed("2006-01-02")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(PrecisionDay, r.Precision, "Incorrect precision")
+	assert.False(r.ExplicitOffset, "Offset must not be explicit")
+
+	r, err = p.ParseDetailed("Jan 02 15:04:05")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(FormatANSIC, r.Format, "Incorrect format")
+	assert.Equal(PrecisionSecond, r.Precision, "Incorrect precision")
+	assert.True(r.Defaulted.Has(FieldYear), "Year must be defaulted")
+	assert.False(r.Defaulted.Has(FieldMonth), "Month must not be defaulted")
+
+	r, err = p.ParseDetailed("15:04")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(PrecisionMinute, r.Precision, "Incorrect precision")
+	assert.True(r.Defaulted.Has(FieldYear|FieldMonth|FieldDay), "Date must be defaulted")
+
+	r, err = p.ParseDetailed("1136214245")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(FormatUnix, r.Format, "Incorrect format")
+	assert.Equal(PrecisionSecond, r.Precision, "Incorrect precision")
+	assert.True(r.ExplicitOffset, "Epoch offset must be explicit")
+}
+
+func TestPrecisionString(test *testing.T) {
+	assert := assert.New(test)
+
+	assert.Equal("day", PrecisionDay.String())
+	assert.Equal("nanosecond", PrecisionNanosecond.String())
+	assert.Equal("unknown", Precision(-1).String())
+}
+
+func TestParseAll(test *testing.T) {
+	assert := assert.New(test)
+	p, _ := NewParseTime()
+
+	results, err := p.ParseAll("01-02-06 03:04:05 PM")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(FormatUS, results[0].Format, "Incorrect format")
+	assert.True(results[0].Ambiguity.Has(AmbiguousDateOrder), "Date order must be ambiguous")
+
+	for i := 1; i < len(results); i++ {
+		assert.True(results[i-1].Priority <= results[i].Priority, "Results must be ranked")
+	}
+
+	// a full date is preferred to a time with a defaulted date
+	readings := []struct {
+		value    string
+		format   string
+		expected time.Time
+	}{
+		{"01-02-06", FormatUS, time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC)},
+		{"Jan 2 2006", FormatUS, time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC)},
+	}
+
+	utc, _ := New(WithLocation(time.UTC))
+	for _, r := range readings {
+		results, err := utc.ParseAll(r.value)
+		assert.Equal(nil, err, r.value)
+		assert.Equal(r.format, results[0].Format, r.value)
+		assert.Equal(r.expected, results[0].Time, r.value)
+	}
+
+	results, err = p.ParseAll("2006-01-02T15:04:05Z")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(FormatISO8601, results[0].Format, "Incorrect format")
+	assert.False(results[0].Ambiguity.Has(AmbiguousDateOrder), "ISO8601 date order is not ambiguous")
+
+	_, err = p.ParseAll("")
+	assert.Equal(nil, err, "Empty input matches the time of day")
+}
+
+func TestParseAllAmbiguousFormat(test *testing.T) {
+	assert := assert.New(test)
+	p, _ := New(WithLocation(time.UTC))
+
+	results, err := p.ParseAll("01/02/06")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.True(results[0].Ambiguity.Has(AmbiguousFormat), "US and DMY readings differ")
+
+	// readings that default more fields, roll over or split numbers do not count
+	values := []string{"20060102", "01/13/2006", "1136214245123", "02-Jan-06 1504"}
+	for _, v := range values {
+		results, err := p.ParseAll(v)
+		assert.Equal(nil, err, v)
+		assert.False(results[0].Ambiguity.Has(AmbiguousFormat), v)
+	}
+
+	results, err = p.ParseAll("yesterday")
+	assert.Equal(nil, err, "Invalid date/time")
+	for _, r := range results {
+		assert.NotEqual(r.Start, r.End, "Zero-width %s match", r.Format)
+	}
+}
+
+func TestParseAllLongerError(test *testing.T) {
+	assert := assert.New(test)
+	p, _ := New(WithFixedZone("JST", 9*3600))
+
+	// the error of a format that read more input than any result
+	errs := []struct {
+		value string
+		field string
+	}{
+		{"2006-01-02T15:04:05+15:00", "offset"},
+		{"Jan 2, 2006 0 AM", "hour"},
+		{"H32.1.1", "year"},
+	}
+
+	for _, e := range errs {
+		_, err := p.ParseAll(e.value)
+
+		var perr *ParseError
+		assert.True(errors.As(err, &perr), e.value)
+		assert.True(errors.Is(err, ErrOutOfRange), e.value)
+		assert.Equal(e.field, perr.Field, e.value)
+	}
+}
@@ -0,0 +1,185 @@
+package parsetime
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// boundedMin is min for anchored patterns: a one-digit minute or second
+// must not be followed by another digit, so 15:60 is not read as 15:6 + 0
+const boundedMin = `([0-5][0-9]|[0-9]\b)`
+
+// anchor compiles a pattern that must match the whole input,
+// ignoring surrounding spaces
+func anchor(pattern string) *regexp.Regexp {
+	return regexp.MustCompile(`^\s*(?:` + strings.ReplaceAll(pattern, min, boundedMin) + `)\s*$`)
+}
+
+// match returns the submatch index of value.
+// In strict mode a whole-input match is preferred; the partial match is
+// returned otherwise so the unmatched text can be reported.
+func (ctx parseContext) match(re, anchored *regexp.Regexp, value string) []int {
+	if ctx.strict {
+		if index := anchored.FindStringSubmatchIndex(value); index != nil {
+			return trimIndex(value, index)
+		}
+	}
+
+	return re.FindStringSubmatchIndex(value)
+}
+
+// trimIndex narrows the whole-match span of an anchored match to exclude
+// the surrounding spaces
+func trimIndex(value string, index []int) []int {
+	index[0] = len(value) - len(strings.TrimLeftFunc(value, unicode.IsSpace))
+	index[1] = len(strings.TrimRightFunc(value, unicode.IsSpace))
+
+	if index[1] < index[0] {
+		index[1] = index[0]
+	}
+
+	return index
+}
+
+// fieldRanges are the valid values of each numeric component in strict mode
+var fieldRanges = map[string][2]int{
+	"month":  {1, 12},
+	"day":    {1, 31},
+	"hour":   {0, 24},
+	"minute": {0, 59},
+	"second": {0, 59},
+}
+
+// checkRange returns ErrOutOfRange if a strict component is outside its range
+func checkRange(name string, value int) error {
+	r, ok := fieldRanges[name]
+	if !ok {
+		return nil
+	}
+
+	if value < r[0] || value > r[1] {
+		return ErrOutOfRange
+	}
+
+	return nil
+}
+
+// checkSplit rejects a number that the optional separators of a pattern
+// split across two components, e.g. the minute 6 of 15:60 or the day 20
+// and month 06 that RFC822 reads from 2006-13-01.
+// A one-digit component must not touch another digit, and neither may a
+// date component unless the format has compact dates (20060102). Other
+// formats read a time from one number only as hhmm or hhmmss that is not
+// in place of a year, so 01/13/2006 is not 20:06 and 20060102 is not
+// 20:06:01.02.
+func (f dateFields) checkSplit(input, format string, compact bool) error {
+	components := []struct {
+		capture
+		name string
+		date bool
+	}{
+		{f.year, "year", true},
+		{f.month, "month", true},
+		{f.day, "day", true},
+		{f.hour, "hour", false},
+		{f.min, "minute", false},
+		{f.sec, "second", false},
+	}
+
+	for _, c := range components {
+		if c.pos < 0 || c.value == "" || !isDigits(c.value) {
+			continue
+		}
+
+		end := c.pos + len(c.value)
+		if end > len(input) || input[c.pos:end] != c.value {
+			continue
+		}
+
+		start := c.pos
+		for start > 0 && isDigit(input[start-1]) {
+			start--
+		}
+
+		for end < len(input) && isDigit(input[end]) {
+			end++
+		}
+
+		run := capture{value: input[start:end], pos: start}
+		if run.value == c.value || (len(c.value) > 1 && (compact || (!c.date && isCompactTime(input, run)))) {
+			continue
+		}
+
+		return newParseError(input, format, run, c.name, ErrOutOfRange)
+	}
+
+	return nil
+}
+
+// isCompactTime reports whether run is an hhmm or hhmmss number that does
+// not follow a date separator
+func isCompactTime(input string, run capture) bool {
+	if len(run.value) != len("1504") && len(run.value) != len("150405") {
+		return false
+	}
+
+	return run.pos == 0 || !strings.ContainsRune("/-.", rune(input[run.pos-1]))
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isDigits(value string) bool {
+	for i := 0; i < len(value); i++ {
+		if !isDigit(value[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// unmatched returns the first run of non-space text outside the match
+func unmatched(input string, r ParseResult) capture {
+	if head := strings.TrimSpace(input[:r.Start]); head != "" {
+		return capture{value: head, pos: strings.Index(input, head)}
+	}
+
+	tail := input[r.End:]
+	pos := strings.IndexFunc(tail, func(c rune) bool { return !unicode.IsSpace(c) })
+	if pos < 0 {
+		return capture{pos: -1}
+	}
+
+	return capture{value: strings.TrimSpace(tail), pos: r.End + pos}
+}
+
+// checkStrict rejects partial matches and missing date components
+func checkStrict(r ParseResult, input string) error {
+	if r.Start == r.End {
+		return noMatchError(input, r.Format)
+	}
+
+	if c := unmatched(input, r); c.pos >= 0 {
+		return newParseError(input, r.Format, c, "text", ErrUnmatchedText)
+	}
+
+	missing := []struct {
+		field Field
+		name  string
+	}{
+		{FieldYear, "year"},
+		{FieldMonth, "month"},
+		{FieldDay, "day"},
+	}
+
+	for _, m := range missing {
+		if r.Defaulted.Has(m.field) {
+			return newParseError(input, r.Format, capture{pos: r.Start}, m.name, ErrMissingField)
+		}
+	}
+
+	return nil
+}
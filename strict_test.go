@@ -0,0 +1,90 @@
+package parsetime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStrict(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := New(WithStrict())
+	assert.True(p.GetStrict(), "Strict mode must be enabled")
+
+	times := []TestTime{
+		{
+			Value: "2006-01-02",
+			Time:  createTimeInLocation("2006-01-02", "2006-01-02", p.GetLocation()),
+		},
+		{
+			Value: " 2006-01-02T15:04:05Z ",
+			Time:  createTime(time.RFC3339, "2006-01-02T15:04:05Z"),
+		},
+		{
+			Value: "Mon, 02-Jan-06 15:04:05 -0700",
+			Time:  time.Date(2006, time.January, 2, 15, 4, 5, 0, time.FixedZone("", -7*3600)),
+		},
+		{
+			Value: "Mon Jan 02 15:04:05 -07:00 2006",
+			Time:  createTime(time.RubyDate, "Mon Jan 02 15:04:05 -0700 2006"),
+		},
+		{
+			Value: "1136214245",
+			Time:  time.Unix(1136214245, 0),
+		},
+	}
+
+	for _, tt := range times {
+		t, err := p.Parse(tt.Value)
+		assert.Equal(nil, err, tt.Value)
+		assert.Equal(tt.Time.Unix(), t.Unix(), tt.Value)
+	}
+}
+
+func TestStrictErrors(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime()
+	p.SetStrict(true)
+
+	errs := []struct {
+		value  string
+		field  string
+		text   string
+		offset int
+		err    error
+	}{
+		{"hello 12 world", "text", "hello", 0, ErrUnmatchedText},
+		{"2006-01-02 garbage", "text", "garbage", 11, ErrUnmatchedText},
+		{"2006-01-02 15:04:05 XYZ", "zone", "XYZ", 20, ErrInvalidTimezone},
+		{"2006-13-01", "month", "13", 5, ErrOutOfRange},
+		{"2006-01-02T15:60", "minute", "60", 14, ErrOutOfRange},
+		{"Jan 2, 2006 13 PM", "hour", "13", 12, ErrOutOfRange},
+		{"15:04:05", "year", "", 0, ErrMissingField},
+		{"", "", "", 0, ErrInvalidDateTime},
+	}
+
+	for _, e := range errs {
+		_, err := p.Parse(e.value)
+
+		var perr *ParseError
+		assert.True(errors.As(err, &perr), e.value)
+		assert.True(errors.Is(err, e.err), e.value)
+		assert.Equal(e.field, perr.Field, e.value)
+		assert.Equal(e.text, perr.Value, e.value)
+		assert.Equal(e.offset, perr.Offset, e.value)
+	}
+
+	_, err := p.ISO8601("2006-01-02 15:04:05 !!")
+	var perr *ParseError
+	assert.True(errors.As(err, &perr), "Error must be a *ParseError")
+	assert.Equal("!!", perr.Value, "Incorrect value")
+	assert.Equal(20, perr.Offset, "Incorrect offset")
+
+	p.SetStrict(false)
+	_, err = p.Parse("2006-01-02 garbage")
+	assert.Equal(nil, err, "Lenient mode accepts partial matches")
+}
@@ -0,0 +1,41 @@
+package parsetime
+
+import (
+	"time"
+)
+
+// defaultTwoDigitYearPivot keeps the historical window: 70-99 -> 1970-1999, 00-69 -> 2000-2069
+const defaultTwoDigitYearPivot = 1970
+
+// yearWindow is the 100-year window two-digit years are placed in
+type yearWindow struct {
+	// pivot is the first year of a fixed window, 0 for the default
+	pivot int
+	// sliding windows end future years after the reference clock's year
+	sliding bool
+	future  int
+}
+
+// start returns the first year of the window for the reference time
+func (w yearWindow) start(now time.Time) int {
+	if w.sliding {
+		return now.Year() + w.future - 99
+	}
+
+	if w.pivot == 0 {
+		return defaultTwoDigitYearPivot
+	}
+
+	return w.pivot
+}
+
+// twoDigitTo4DigitYear places a two-digit year in the 100 years from start,
+// e.g. with start 1930: 45 -> 1945, 29 -> 2029
+func twoDigitTo4DigitYear(year int, start int) int {
+	val := start - start%100 + year
+	if val < start {
+		val += 100
+	}
+
+	return val
+}
@@ -0,0 +1,114 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTwoDigitTo4DigitYear(test *testing.T) {
+	assert := assert.New(test)
+
+	tests := []struct {
+		year, start, expected int
+	}{
+		{70, 1970, 1970},
+		{99, 1970, 1999},
+		{0, 1970, 2000},
+		{69, 1970, 2069},
+		{45, 1930, 1945},
+		{29, 1930, 2029},
+		{30, 1930, 1930},
+		{0, 1900, 1900},
+		{99, 1900, 1999},
+	}
+
+	for _, t := range tests {
+		assert.Equal(t.expected, twoDigitTo4DigitYear(t.year, t.start), "Incorrect year")
+	}
+}
+
+func TestTwoDigitYearPivot(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := New(WithFixedZone("UTC", 0))
+	assert.Equal(1970, p.GetTwoDigitYearStart(), "Incorrect default window")
+
+	t, _ := p.Parse("02-Jan-45 15:04:05 -07:00")
+	assert.Equal(2045, t.Year(), "Incorrect default year")
+
+	p, err := New(WithFixedZone("UTC", 0), WithTwoDigitYearPivot(1930))
+	assert.Equal(nil, err, "Invalid options")
+
+	times := map[string]int{
+		"02-Jan-45 15:04:05 -07:00": 1945,
+		"02-Jan-29 15:04:05 -07:00": 2029,
+		"01/02/45":                  1945,
+		"Jan 2, 45":                 1945,
+		"02/01/45":                  1945,
+	}
+
+	for in, year := range times {
+		t, err := p.Parse(in)
+		assert.Equal(nil, err, in)
+		assert.Equal(year, t.Year(), in)
+	}
+
+	p.SetTwoDigitYearPivot(0)
+	assert.Equal(1970, p.GetTwoDigitYearStart(), "Incorrect restored window")
+}
+
+func TestSlidingTwoDigitYearWindow(test *testing.T) {
+	assert := assert.New(test)
+
+	ref := time.Date(2024, time.May, 6, 0, 0, 0, 0, time.UTC)
+	p, err := New(WithClock(FixedClock(ref)), WithSlidingTwoDigitYearWindow(0))
+	assert.Equal(nil, err, "Invalid options")
+	assert.Equal(1925, p.GetTwoDigitYearStart(), "Incorrect sliding window")
+
+	t, _ := p.Parse("01/02/24")
+	assert.Equal(2024, t.Year(), "Incorrect year")
+	t, _ = p.Parse("01/02/25")
+	assert.Equal(1925, t.Year(), "Incorrect year")
+
+	p.SetSlidingTwoDigitYearWindow(20)
+	t, _ = p.Parse("01/02/44")
+	assert.Equal(2044, t.Year(), "Incorrect year")
+	t, _ = p.Parse("01/02/45")
+	assert.Equal(1945, t.Year(), "Incorrect year")
+}
+
+func TestFourDigitYears(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := New(WithFixedZone("UTC", 0))
+
+	tests := map[string]time.Time{
+		"1945-05-08":                      time.Date(1945, time.May, 8, 0, 0, 0, 0, time.UTC),
+		"18000101":                        time.Date(1800, time.January, 1, 0, 0, 0, 0, time.UTC),
+		"0999-12-31T23:59:59Z":            time.Date(999, time.December, 31, 23, 59, 59, 0, time.UTC),
+		"Mon, 02-Jan-1900 15:04:05 +0000": time.Date(1900, time.January, 2, 15, 4, 5, 0, time.UTC),
+		"Jan 2, 1850":                     time.Date(1850, time.January, 2, 0, 0, 0, 0, time.UTC),
+		"Mon Jan 02 15:04:05 1901":        time.Date(1901, time.January, 2, 15, 4, 5, 0, time.UTC),
+	}
+
+	for in, expected := range tests {
+		t, err := p.Parse(in)
+		assert.Equal(nil, err, in)
+		assert.True(expected.Equal(t), in)
+	}
+}
+
+func TestTwoDigitYearInvalidOptions(test *testing.T) {
+	assert := assert.New(test)
+
+	_, err := New(WithTwoDigitYearPivot(0))
+	assert.NotNil(err, "pivot 0 must fail")
+
+	_, err = New(WithSlidingTwoDigitYearWindow(-1))
+	assert.NotNil(err, "negative window must fail")
+
+	_, err = New(WithSlidingTwoDigitYearWindow(100))
+	assert.NotNil(err, "window over 99 years must fail")
+}